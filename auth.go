@@ -0,0 +1,84 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHeader is the header the API key is sent in, as declared by the
+// ApiKeyAuth security scheme of the spec.
+const APIKeyHeader = "X-API-Key"
+
+// TokenSource returns the token to authenticate a request with. It is called
+// for every request, so it may refresh the token as needed.
+type TokenSource func(ctx context.Context) (string, error)
+
+// BearerTokenEditor returns a RequestEditorFn which sets the Authorization
+// header of each request to a bearer token obtained from source. Pass it to
+// WithRequestEditorFn to authenticate every request of a client, or as a
+// trailing argument to authenticate a single call.
+func BearerTokenEditor(source TokenSource) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		token, err := source(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// APIKeyValidator checks an API key and returns the principal it belongs to.
+// ok is false if the key is not valid.
+type APIKeyValidator func(key string) (principal string, ok bool)
+
+type principalKey struct{}
+
+// RequireAPIKey returns a middleware which authenticates requests by their
+// X-API-Key header. Requests with a missing, malformed or invalid key are
+// rejected with 401. The principal of an authenticated request is available
+// to the handler through Principal. Operations which the spec declares with no
+// security, like the probes, are let through unauthenticated.
+func RequireAPIKey(validator APIKeyValidator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isPublic(c.Request().Method, c.Path()) {
+				return next(c)
+			}
+			values := c.Request().Header.Values(APIKeyHeader)
+			if len(values) == 0 {
+				return unauthorized(c, "missing "+APIKeyHeader+" header")
+			}
+			key := strings.TrimSpace(values[0])
+			if len(values) > 1 || key == "" {
+				return unauthorized(c, "malformed "+APIKeyHeader+" header")
+			}
+			principal, ok := validator(key)
+			if !ok {
+				return unauthorized(c, "invalid API key")
+			}
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(context.WithValue(req.Context(), principalKey{}, principal)))
+			return next(c)
+		}
+	}
+}
+
+// Principal returns the principal RequireAPIKey authenticated the request
+// with ctx as its context, and whether there is one. Echo handlers pass
+// c.Request().Context().
+func Principal(ctx context.Context) (string, bool) {
+	principal, ok := ctx.Value(principalKey{}).(string)
+	return principal, ok
+}
+
+func unauthorized(c echo.Context, message string) error {
+	return c.JSON(http.StatusUnauthorized, Error{
+		Code:    "unauthorized",
+		Message: message,
+	})
+}
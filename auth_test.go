@@ -0,0 +1,81 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// principalServer responds to GetOrderId with the principal of the request.
+type principalServer struct {
+	ServerInterface
+}
+
+func (principalServer) GetOrderId(c echo.Context, id string, params GetOrderIdParams) error {
+	principal, ok := Principal(c.Request().Context())
+	if !ok {
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	return c.String(http.StatusOK, principal)
+}
+
+func TestRequireAPIKey(t *testing.T) {
+	validator := func(key string) (string, bool) {
+		return "alice", key == "alice-key"
+	}
+	e := echo.New()
+	err := RegisterHandlersWithOptions(e, principalServer{NewInMemoryStore()}, WithMiddleware(RequireAPIKey(validator)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		keys        []string
+		wantStatus  int
+		wantMessage string
+	}{
+		{"valid key", []string{"alice-key"}, http.StatusOK, ""},
+		{"valid key with spaces", []string{" alice-key "}, http.StatusOK, ""},
+		{"missing header", nil, http.StatusUnauthorized, "missing X-API-Key header"},
+		{"empty header", []string{""}, http.StatusUnauthorized, "malformed X-API-Key header"},
+		{"two headers", []string{"alice-key", "alice-key"}, http.StatusUnauthorized, "malformed X-API-Key header"},
+		{"unknown key", []string{"mallory-key"}, http.StatusUnauthorized, "invalid API key"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/order/234578", nil)
+			for _, key := range tt.keys {
+				req.Header.Add(APIKeyHeader, key)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus == http.StatusOK {
+				if got := rec.Body.String(); got != "alice" {
+					t.Errorf("got the principal %q, want alice", got)
+				}
+				return
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Code != "unauthorized" || rsp.Message != tt.wantMessage || rsp.Details != nil {
+				t.Errorf("got the Error %s, want unauthorized: %s", rec.Body, tt.wantMessage)
+			}
+		})
+	}
+
+	// The probes are public.
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("got status %d for /healthz without a key, want 200", rec.Code)
+	}
+}
@@ -0,0 +1,39 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestWithBaseURL(t *testing.T) {
+	e := echo.New()
+	RegisterHandlers(e.Group("/api/v1"), NewInMemoryStore())
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	for _, baseURL := range []string{server.URL + "/api/v1", server.URL + "/api/v1/", server.URL + "/api/v1//"} {
+		client, err := NewClientWithResponses("http://unused.example.com", WithBaseURL(baseURL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rsp, err := client.GetHealthzWithResponse(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			t.Errorf("%s: got status %d, want 200", baseURL, rsp.StatusCode())
+		}
+	}
+}
+
+func TestWithBaseURLInvalid(t *testing.T) {
+	for _, baseURL := range []string{"", "/api/v1", "ftp://host/api", "https://host/api?x=1", "https://host/api#x"} {
+		if _, err := NewClient("", WithBaseURL(baseURL)); err == nil {
+			t.Errorf("%q: got no error", baseURL)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultMaxBodyBytes is the largest request body RegisterHandlersWithOptions
+// accepts unless WithMaxBodyBytes says otherwise.
+const DefaultMaxBodyBytes = 1 << 20
+
+// WithMaxBodyBytes rejects requests with a body larger than n bytes with 413,
+// instead of DefaultMaxBodyBytes. A compressed body is limited by its size
+// after decompression. n <= 0 disables the limit.
+func WithMaxBodyBytes(n int64) RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.maxBodyBytes = n
+	}
+}
+
+// maxBodyBytes returns a middleware which reads the request body up front,
+// rejecting it with 413 if it is larger than n bytes, so that neither the
+// validation nor the handler ever hold more than n bytes of it, and with 400
+// if it can't be read.
+func maxBodyBytes(n int64) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.ContentLength > n {
+				return payloadTooLarge(c, n)
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, n))
+			req.Body.Close()
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					return payloadTooLarge(c, n)
+				}
+				// Like a body which fails to bind, e.g. a corrupt gzip one.
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			return next(c)
+		}
+	}
+}
+
+func payloadTooLarge(c echo.Context, n int64) error {
+	return c.JSON(http.StatusRequestEntityTooLarge, Error{
+		Code:    "payload_too_large",
+		Message: fmt.Sprintf("the request body must not be larger than %d bytes", n),
+	})
+}
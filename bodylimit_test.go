@@ -0,0 +1,108 @@
+package spec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestMaxBodyBytes(t *testing.T) {
+	const limit = 128
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithMaxBodyBytes(limit)); err != nil {
+		t.Fatal(err)
+	}
+
+	order := `{"item":"Tea Table Green","price":1499}`
+	for _, tt := range []struct {
+		name    string
+		size    int
+		chunked bool
+		want    int
+	}{
+		{"at the limit", limit, false, http.StatusCreated},
+		{"one byte over", limit + 1, false, http.StatusRequestEntityTooLarge},
+		// Without a Content-Length, the body is only found too large when
+		// it is read.
+		{"chunked at the limit", limit, true, http.StatusCreated},
+		{"chunked one byte over", limit + 1, true, http.StatusRequestEntityTooLarge},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// JSON allows trailing whitespace, so the order can be padded
+			// to any size.
+			body := order + strings.Repeat(" ", tt.size-len(order))
+			req := httptest.NewRequest(http.MethodPut, "/order/234578", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want != http.StatusRequestEntityTooLarge {
+				return
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Code != "payload_too_large" {
+				t.Errorf("got code %q, want payload_too_large", rsp.Code)
+			}
+		})
+	}
+}
+
+// TestMaxBodyBytesUnreadable checks that a body which can't be read, like a
+// corrupt gzip one, is rejected with 400 whether or not it is limited.
+func TestMaxBodyBytesUnreadable(t *testing.T) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write([]byte(`{"item":"Tea Table Green","price":1499}`))
+	zw.Close()
+	corrupt := compressed.Bytes()
+	for i := 10; i < len(corrupt)-8; i++ {
+		corrupt[i] ^= 0xff
+	}
+
+	for _, tt := range []struct {
+		name string
+		opts []RegisterOption
+	}{
+		{"limited", nil},
+		{"unlimited", []RegisterOption{WithMaxBodyBytes(0)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			opts := append([]RegisterOption{WithMiddleware(GzipMiddleware())}, tt.opts...)
+			if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), opts...); err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/order", bytes.NewReader(corrupt))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderContentEncoding, "gzip")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Code != "invalid_request" {
+				t.Errorf("got code %q, want invalid_request", rsp.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package spec
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a response to GetOrderId kept by WithResponseCache.
+type CachedResponse struct {
+	Header http.Header
+	Body   []byte
+	// Expires is when the response stops being fresh. Until then, it is used
+	// without asking the server, and after, once the server confirmed with
+	// 304 that it is still current.
+	Expires time.Time
+}
+
+// Cache keeps the responses of WithResponseCache, by URL. Implementations
+// must be safe for concurrent use, and may evict responses at any time.
+type Cache interface {
+	// Get returns the response cached for key, and whether there is one.
+	Get(key string) (CachedResponse, bool)
+	// Set caches rsp for key.
+	Set(key string, rsp CachedResponse)
+}
+
+// NewInMemoryCache returns a Cache which keeps responses in memory, forever.
+func NewInMemoryCache() Cache {
+	return &inMemoryCache{responses: make(map[string]CachedResponse)}
+}
+
+type inMemoryCache struct {
+	mu        sync.Mutex
+	responses map[string]CachedResponse
+}
+
+func (c *inMemoryCache) Get(key string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rsp, ok := c.responses[key]
+	return rsp, ok
+}
+
+func (c *inMemoryCache) Set(key string, rsp CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = rsp
+}
+
+// WithResponseCache caches the responses to GetOrderId in cache, as their
+// Cache-Control header allows. A response is used without a request for as
+// long as its max-age says. After that, or with no-cache, it is revalidated
+// with If-None-Match, and a 304 gets the cached response too. Requests with
+// their own If-None-Match or If-Modified-Since bypass the cache, and so does
+// anything with no-store. PUT, PATCH and DELETE requests for an order through
+// the client make its cached response stale, but not the ones of reads with
+// fields.
+//
+// Responses are cached by URL, so a cache must not be shared by clients of
+// different principals.
+func WithResponseCache(cache Cache) ClientOption {
+	return func(c *Client) error {
+		c.setLayer(cacheLayer, func(doer HttpRequestDoer) HttpRequestDoer {
+			return &cachingDoer{doer: doer, cache: cache, client: c}
+		})
+		return nil
+	}
+}
+
+type cachingDoer struct {
+	doer   HttpRequestDoer
+	cache  Cache
+	client *Client
+}
+
+func (d *cachingDoer) Do(req *http.Request) (*http.Response, error) {
+	switch clientOperationID(d.client.Server, req) {
+	case OpGetOrderId:
+	case OpPutOrderId, OpPatchOrderId, OpDeleteOrderId:
+		return d.expire(req)
+	default:
+		return d.doer.Do(req)
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return d.doer.Do(req)
+	}
+
+	key := req.URL.String()
+	cached, ok := d.cache.Get(key)
+	if ok && time.Now().Before(cached.Expires) {
+		return cached.response(req), nil
+	}
+	revalidate := ok && cached.Header.Get("ETag") != ""
+	if revalidate {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.Header.Get("ETag"))
+	}
+
+	rsp, err := d.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case revalidate && rsp.StatusCode == http.StatusNotModified:
+		io.Copy(io.Discard, rsp.Body)
+		rsp.Body.Close()
+		// A 304 updates the headers of the cached response, e.g. its
+		// Cache-Control.
+		for name, values := range rsp.Header {
+			if name != "Content-Length" {
+				cached.Header[name] = values
+			}
+		}
+		cached.Expires, _ = freshUntil(cached.Header)
+		d.cache.Set(key, cached)
+		return cached.response(req), nil
+
+	case rsp.StatusCode == http.StatusOK:
+		expires, ok := freshUntil(rsp.Header)
+		if !ok {
+			return rsp, nil
+		}
+		body, err := io.ReadAll(rsp.Body)
+		rsp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		rsp.Body = io.NopCloser(bytes.NewReader(body))
+		d.cache.Set(key, CachedResponse{Header: rsp.Header.Clone(), Body: body, Expires: expires})
+	}
+	return rsp, nil
+}
+
+// expire does req, which changes an order, and makes the cached response to
+// reading the order stale if it succeeds, so that the next read revalidates
+// it.
+func (d *cachingDoer) expire(req *http.Request) (*http.Response, error) {
+	rsp, err := d.doer.Do(req)
+	if err != nil || rsp.StatusCode >= http.StatusMultipleChoices {
+		return rsp, err
+	}
+	u := *req.URL
+	u.RawQuery = ""
+	if cached, ok := d.cache.Get(u.String()); ok {
+		cached.Expires = time.Time{}
+		d.cache.Set(u.String(), cached)
+	}
+	return rsp, nil
+}
+
+// freshUntil returns until when a response with header is fresh according to
+// its Cache-Control, and whether it may be cached at all. A response which
+// is never fresh is only worth caching if it has an ETag to revalidate it
+// with.
+func freshUntil(header http.Header) (time.Time, bool) {
+	var maxAge time.Duration
+	noCache := false
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return time.Time{}, false
+		case "no-cache":
+			noCache = true
+		case "max-age":
+			if seconds, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil && seconds > 0 {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	if noCache {
+		maxAge = 0
+	}
+	return time.Now().Add(maxAge), maxAge > 0 || header.Get("ETag") != ""
+}
+
+// response returns the cached response as the response to req.
+func (r CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,54 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestResponseCacheRevalidation checks that a cached response the server
+// confirms with 304 is returned as its 200, body and all.
+func TestResponseCacheRevalidation(t *testing.T) {
+	const body = `{"id":"234578","item":"Tea Table Green","price":1499}`
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL, WithResponseCache(NewInMemoryCache()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		rsp, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rsp.StatusCode() != http.StatusOK {
+			t.Fatalf("read %d: got status %d, want 200", i, rsp.StatusCode())
+		}
+		if string(rsp.Body) != body {
+			t.Errorf("read %d: got the body %s, want %s", i, rsp.Body, body)
+		}
+		if rsp.JSON200 == nil || rsp.JSON200.Price == nil || *rsp.JSON200.Price != 1499 {
+			t.Errorf("read %d: got the order %+v, want the cached one", i, rsp.JSON200)
+		}
+		if got := rsp.HTTPResponse.Header.Get("ETag"); got != `"v1"` {
+			t.Errorf("read %d: got the ETag %s, want \"v1\"", i, got)
+		}
+	}
+	if requests != 3 || notModified != 2 {
+		t.Errorf("got %d requests, %d of them answered with 304, want 3 and 2", requests, notModified)
+	}
+}
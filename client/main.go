@@ -3,27 +3,214 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"os"
+	"time"
 
 	spec "article-openapi"
+
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 )
 
-const server = "http://localhost:8088"
+const apiKey = "secret-key"
 
 func main() {
-	client, err := spec.NewClientWithResponses(server)
+	// Any *http.Client works here, e.g. one with a proxy or TLS config.
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	auth, err := securityprovider.NewSecurityProviderApiKey("header", spec.APIKeyHeader, apiKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The server defaults to the first of the spec, and API_SERVER selects
+	// another by name, e.g. Staging.
+	server := spec.DefaultServer()
+	if name := os.Getenv("API_SERVER"); name != "" {
+		var ok bool
+		if server, ok = spec.ServerByName(name); !ok {
+			log.Fatalf("unknown server %s", name)
+		}
+	}
+
+	client, err := spec.NewClientWithResponses(server,
+		spec.WithHTTPClient(httpClient),
+		spec.WithUserAgent("article-openapi-example "+spec.DefaultUserAgent),
+		spec.WithCompression(),
+		spec.WithResponseCache(spec.NewInMemoryCache()),
+		spec.WithRetry(3, spec.ExponentialBackoff(100*time.Millisecond, 2*time.Second)),
+		spec.WithRequestEditorFn(auth.Intercept),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The context bounds the whole round trip of every call, including
+	// reading the response body.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	version, err := client.GetVersionWithResponse(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if version.JSON200 == nil || version.JSON200.Version != spec.SpecVersion {
+		log.Printf("server API version %s differs from client version %s", version.HTTPResponse.Header.Get(spec.APIVersionHeader), spec.SpecVersion)
+	}
 
 	item := spec.OrderItemTeaTableGreen
-	price := 14
-	resp, err := client.PutOrderIdWithResponse(context.Background(), "234578", spec.PutOrderIdJSONRequestBody{
-		Item: &item, Price: &price,
+	total := spec.Money{Amount: 1400, Currency: spec.CurrencyEUR}
+	resp, err := client.PutOrderIdWithResponse(ctx, "234578", &spec.PutOrderIdParams{}, spec.PutOrderIdJSONRequestBody{
+		Item: item, Total: &total,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(resp.StatusCode(), resp.ETag())
+
+	order, err := client.GetOrderIdWithResponse(ctx, "234578", &spec.GetOrderIdParams{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(order.StatusCode(), string(order.Body))
+
+	// Reading the order again gives 304, without a body, as it didn't change.
+	since := spec.HTTPDate(order.LastModified())
+	unchanged, err := client.GetOrderIdWithResponse(ctx, "234578", &spec.GetOrderIdParams{IfModifiedSince: &since})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(unchanged.StatusCode(), unchanged.JSON200 == nil)
+
+	// Only the total of the order, for a smaller response.
+	fields := []string{"total"}
+	sparse, err := client.GetOrderIdWithResponse(ctx, "234578", &spec.GetOrderIdParams{Fields: &fields})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(sparse.StatusCode(), string(sparse.Body))
+
+	// The raw methods return the *http.Response as is, for reading headers
+	// or decoding the body without buffering it.
+	raw, err := client.GetOrderId(ctx, "234578", &spec.GetOrderIdParams{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(raw.Status, " ", raw.Header.Get("Content-Type"), " ")
+	_, err = io.Copy(os.Stdout, raw.Body)
+	raw.Body.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Only update the total if nobody changed the order since we read it.
+	etag := order.ETag()
+	total.Amount++
+	updated, err := client.PutOrderIdWithResponse(ctx, "234578", &spec.PutOrderIdParams{IfMatch: &etag}, spec.PutOrderIdJSONRequestBody{
+		Item: item, Total: &total,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(updated.StatusCode(), updated.ETag())
+
+	stale, err := client.PutOrderIdWithResponse(ctx, "234578", &spec.PutOrderIdParams{IfMatch: &etag}, spec.PutOrderIdJSONRequestBody{
+		Item: item, Total: &total,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(stale.StatusCode(), stale.JSON412.Message)
+
+	// With If-None-Match: *, the put only creates the order, so it fails now
+	// that the order exists.
+	wildcard := "*"
+	existing, err := client.PutOrderIdWithResponse(ctx, "234578", &spec.PutOrderIdParams{IfNoneMatch: &wildcard}, spec.PutOrderIdJSONRequestBody{
+		Item: item, Total: &total,
 	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Println(resp.StatusCode())
+	fmt.Println(existing.StatusCode(), existing.JSON412.Message)
+
+	// Only change the total, leaving the item as it is.
+	patched, err := client.PatchOrderIdWithResponse(ctx, "234578", spec.PatchOrderIdJSONRequestBody{
+		Total: &spec.Money{Amount: 1000, Currency: spec.CurrencyEUR},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(patched.StatusCode(), string(patched.Body))
+
+	deleted, err := client.DeleteOrderIdWithResponse(ctx, "234578")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(deleted.StatusCode())
+
+	// Retrying a creation with the same Idempotency-Key returns the order
+	// created the first time rather than another one.
+	idempotencyKey := fmt.Sprintf("example-%d", time.Now().UnixNano())
+	newOrder := spec.PostOrderJSONRequestBody{
+		Item: &item, Total: &spec.Money{Amount: 1499, Currency: spec.CurrencyEUR},
+	}
+	for i := 0; i < 2; i++ {
+		created, err := client.PostOrderWithResponse(ctx, &spec.PostOrderParams{IdempotencyKey: &idempotencyKey}, newOrder)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(created.StatusCode(), created.Location(), created.JSON201.Id)
+	}
+
+	// Each order of a batch succeeds or fails on its own, and the results
+	// come in the order of the orders.
+	batch, err := client.BatchCreateOrdersWithResponse(ctx, spec.BatchCreateOrdersJSONRequestBody{
+		Orders: []spec.OrderInput{
+			{Item: &item, Total: &total},
+			{Item: &item, Total: &spec.Money{Amount: 0, Currency: spec.CurrencyEUR}},
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for i, result := range batch.JSON200.Results {
+		if result.Error != nil {
+			fmt.Println(i, result.Error.Message, *result.Error.Details)
+			continue
+		}
+		fmt.Println(i, *result.Id)
+	}
+
+	limit := 10
+	list, err := client.ListOrdersWithResponse(ctx, &spec.ListOrdersParams{Limit: &limit})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(list.StatusCode(), string(list.Body))
+
+	// The export is decoded one order at a time, however many there are.
+	orders, err := client.ExportOrdersSeq(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for order, err := range orders {
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(*order.Id)
+	}
 }
@@ -0,0 +1,146 @@
+package spec
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// slowServer returns a server which only sends the headers of its responses
+// once the test ends, as a server which hangs would.
+func slowServer(t *testing.T) *httptest.Server {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+	return server
+}
+
+func TestClientCancel(t *testing.T) {
+	server := slowServer(t)
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err = client.PutOrderIdWithResponse(ctx, "234578", &PutOrderIdParams{}, PutOrderIdJSONRequestBody{
+		Item: OrderItemTeaTableGreen, Total: &Money{Amount: 1499, Currency: CurrencyEUR},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the call returned %s after the cancellation", elapsed)
+	}
+}
+
+func TestClientDeadline(t *testing.T) {
+	server := slowServer(t)
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = client.GetOrderIdWithResponse(ctx, "234578", &GetOrderIdParams{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the call returned %s after the deadline", elapsed)
+	}
+}
+
+func TestClientCancelDuringBody(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err = client.GetOrderIdWithResponse(ctx, "234578", &GetOrderIdParams{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestClientOptionOrder(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("attempt %d: got Accept-Encoding %q, want gzip", attempts, r.Header.Get("Accept-Encoding"))
+		}
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"234578","item":"Tea Table Green"}`))
+	}))
+	defer server.Close()
+
+	var observed []int
+	options := []ClientOption{
+		WithRetry(2, func(int) time.Duration { return time.Millisecond }),
+		WithCompression(),
+		WithResponseObserver(func(op string, status int, dur time.Duration) {
+			observed = append(observed, status)
+		}),
+	}
+	for name, options := range map[string][]ClientOption{
+		"WithHTTPClient first": append([]ClientOption{WithHTTPClient(server.Client())}, options...),
+		"WithHTTPClient last":  append(options[:len(options):len(options)], WithHTTPClient(server.Client())),
+	} {
+		t.Run(name, func(t *testing.T) {
+			attempts, observed = 0, nil
+			client, err := NewClientWithResponses(server.URL, options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rsp, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rsp.StatusCode() != http.StatusOK {
+				t.Errorf("got status %d, want 200", rsp.StatusCode())
+			}
+			if attempts != 3 {
+				t.Errorf("got %d attempts, want 3", attempts)
+			}
+			if len(observed) != 1 || observed[0] != http.StatusOK {
+				t.Errorf("observed %v, want [200]", observed)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package spec
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// WithCompression makes the client ask for gzip compressed responses, which
+// it decompresses before they are decoded.
+func WithCompression() ClientOption {
+	return func(c *Client) error {
+		c.setLayer(compressionLayer, func(doer HttpRequestDoer) HttpRequestDoer {
+			return &compressionDoer{doer: doer}
+		})
+		return nil
+	}
+}
+
+type compressionDoer struct {
+	doer HttpRequestDoer
+}
+
+func (d *compressionDoer) Do(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	rsp, err := d.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(rsp.Header.Get("Content-Encoding"), "gzip") {
+		rsp.Body = &gzipReader{body: rsp.Body, what: "response"}
+		rsp.Header.Del("Content-Encoding")
+		rsp.Header.Del("Content-Length")
+		rsp.ContentLength = -1
+		rsp.Uncompressed = true
+	}
+	return rsp, nil
+}
+
+// GzipMiddleware returns a middleware which decompresses gzip encoded request
+// bodies before they are bound, and compresses responses for clients which
+// accept gzip.
+func GzipMiddleware() echo.MiddlewareFunc {
+	compress := middleware.Gzip()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		next = compress(next)
+		return func(c echo.Context) error {
+			req := c.Request()
+			if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+				req.Body = &gzipReader{body: req.Body, what: "request"}
+				req.Header.Del("Content-Encoding")
+				req.Header.Del("Content-Length")
+				req.ContentLength = -1
+			}
+			return next(c)
+		}
+	}
+}
+
+// gzipReader decompresses a gzip encoded body as it is read. A truncated or
+// corrupt stream fails the read with an error saying so, rather than passing
+// for the end of the body. gzip only checks the trailer of the stream at its
+// end, which a JSON decoder never reads up to, so the reader reads a byte
+// ahead: the last of the body is only returned along with the checked end.
+type gzipReader struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	what string
+
+	next    byte
+	hasNext bool
+	err     error
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	if r.zr == nil {
+		zr, err := gzip.NewReader(r.body)
+		if err == io.EOF {
+			// An empty body has nothing to decompress.
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error decompressing %s body: %w", r.what, err)
+		}
+		r.zr = zr
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var n int
+	if r.hasNext {
+		p[0], r.hasNext, n = r.next, false, 1
+	}
+	if r.err == nil && n < len(p) {
+		var m int
+		m, r.err = r.zr.Read(p[n:])
+		n += m
+	}
+	for r.err == nil && !r.hasNext {
+		var b [1]byte
+		var m int
+		m, r.err = r.zr.Read(b[:])
+		r.next, r.hasNext = b[0], m == 1
+	}
+
+	switch {
+	case r.err != nil && r.err != io.EOF:
+		return 0, fmt.Errorf("error decompressing %s body: %w", r.what, r.err)
+	case r.err == io.EOF && !r.hasNext:
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *gzipReader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,105 @@
+package spec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func gzipped(t *testing.T, body string) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	zw := gzip.NewWriter(&b)
+	if _, err := zw.Write([]byte(body)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// TestGzipMiddlewareTruncated checks that a request body cut off before the
+// trailer of its gzip stream, which holds its checksum and size, is rejected
+// rather than bound.
+func TestGzipMiddlewareTruncated(t *testing.T) {
+	body := gzipped(t, `{"item":"Tea Table Green","price":1499}`)
+	for _, tt := range []struct {
+		name string
+		body []byte
+		opts []RegisterOption
+		want int
+	}{
+		{"whole", body, nil, http.StatusCreated},
+		{"whole, unlimited", body, []RegisterOption{WithMaxBodyBytes(0)}, http.StatusCreated},
+		{"truncated", body[:len(body)-4], nil, http.StatusBadRequest},
+		{"truncated, unlimited", body[:len(body)-4], []RegisterOption{WithMaxBodyBytes(0)}, http.StatusBadRequest},
+		{"without trailer, unlimited", body[:len(body)-8], []RegisterOption{WithMaxBodyBytes(0)}, http.StatusBadRequest},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			opts := append([]RegisterOption{WithMiddleware(GzipMiddleware())}, tt.opts...)
+			if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), opts...); err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/order", bytes.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderContentEncoding, "gzip")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("got status %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+// TestCompressionTruncated checks that the client fails on a response body
+// cut off before the trailer of its gzip stream, rather than decoding it.
+func TestCompressionTruncated(t *testing.T) {
+	body := gzipped(t, `{"id":"234578","item":"Tea Table Green","price":1499}`)
+	for _, tt := range []struct {
+		name    string
+		body    []byte
+		wantErr bool
+	}{
+		{"whole", body, false},
+		{"truncated", body[:len(body)-4], true},
+		{"without trailer", body[:len(body)-8], true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Accept-Encoding") != "gzip" {
+					t.Errorf("got Accept-Encoding %q, want gzip", r.Header.Get("Accept-Encoding"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write(tt.body)
+			}))
+			defer server.Close()
+			client, err := NewClientWithResponses(server.URL, WithCompression())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rsp, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("got the order %+v, want an error", rsp.JSON200)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rsp.JSON200 == nil || rsp.JSON200.Id == nil || *rsp.JSON200.Id != "234578" {
+				t.Errorf("got the order %+v, want 234578", rsp.JSON200)
+			}
+		})
+	}
+}
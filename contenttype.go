@@ -0,0 +1,46 @@
+package spec
+
+import (
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// requireContentType returns a middleware which rejects requests whose body
+// has a content type the request body of their operation doesn't declare
+// with 415, before the body is read. So is a request without Content-Type
+// which has a body, or whose operation requires one.
+func requireContentType() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			op := findRoute(req.Method, c.Path())
+			if op == nil || op.spec.RequestBody == nil || op.spec.RequestBody.Value == nil {
+				return next(c)
+			}
+			body := op.spec.RequestBody.Value
+
+			contentType := req.Header.Get(echo.HeaderContentType)
+			if contentType == "" {
+				if req.ContentLength == 0 && !body.Required {
+					return next(c)
+				}
+			} else if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && body.Content.Get(mediaType) != nil {
+				return next(c)
+			}
+
+			accepted := make([]string, 0, len(body.Content))
+			for mediaType := range body.Content {
+				accepted = append(accepted, mediaType)
+			}
+			sort.Strings(accepted)
+			return c.JSON(http.StatusUnsupportedMediaType, Error{
+				Code:    errorCode(http.StatusUnsupportedMediaType),
+				Message: "Content-Type must be " + strings.Join(accepted, " or "),
+			})
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package spec
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+// CORSOptions configures CORSMiddleware.
+type CORSOptions struct {
+	// AllowedOrigins are the origins browsers may call the API from, e.g.
+	// https://app.example.com. "*" allows any origin, unless AllowCredentials
+	// is set, in which case only the origins listed explicitly are allowed.
+	AllowedOrigins []string
+	// AllowedMethods defaults to the methods of the operations of the spec.
+	AllowedMethods []string
+	// AllowedHeaders defaults to the request headers of the spec: the header
+	// parameters of its operations, like If-Match or Prefer, the header of
+	// its API key and Content-Type.
+	AllowedHeaders []string
+	// ExposedHeaders defaults to the response headers of the spec, like ETag
+	// or Preference-Applied, and X-API-Version.
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and HTTP authentication.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache the result of a preflight
+	// request. Zero leaves it to the browser.
+	MaxAge time.Duration
+}
+
+// CORSMiddleware returns a middleware which lets browsers call the API from
+// the origins allowed by opts. It answers preflight requests itself, without
+// calling the handler, so it must run before authentication. Responses to
+// other origins carry no Access-Control headers, so browsers block them.
+//
+// Route middleware only runs for the methods of the spec, so preflight
+// requests don't reach it: install it with WithCORS, which also adds the
+// OPTIONS routes, or with echo.Echo.Use.
+func CORSMiddleware(opts CORSOptions) echo.MiddlewareFunc {
+	if opts.AllowedMethods == nil {
+		opts.AllowedMethods = specMethods()
+	}
+	if opts.AllowedHeaders == nil || opts.ExposedHeaders == nil {
+		requestHeaders, responseHeaders := specHeaders()
+		if opts.AllowedHeaders == nil {
+			opts.AllowedHeaders = requestHeaders
+		}
+		if opts.ExposedHeaders == nil {
+			opts.ExposedHeaders = responseHeaders
+		}
+	}
+	allowMethods := strings.Join(opts.AllowedMethods, ", ")
+	allowHeaders := strings.Join(opts.AllowedHeaders, ", ")
+	exposeHeaders := strings.Join(opts.ExposedHeaders, ", ")
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			header := c.Response().Header()
+			origin := req.Header.Get(echo.HeaderOrigin)
+			preflight := req.Method == http.MethodOptions && req.Header.Get(echo.HeaderAccessControlRequestMethod) != ""
+
+			header.Add(echo.HeaderVary, echo.HeaderOrigin)
+			allowOrigin, ok := opts.allowOrigin(origin)
+			if !ok {
+				if preflight {
+					return c.NoContent(http.StatusNoContent)
+				}
+				return next(c)
+			}
+
+			header.Set(echo.HeaderAccessControlAllowOrigin, allowOrigin)
+			if opts.AllowCredentials {
+				header.Set(echo.HeaderAccessControlAllowCredentials, "true")
+			}
+			if !preflight {
+				if exposeHeaders != "" {
+					header.Set(echo.HeaderAccessControlExposeHeaders, exposeHeaders)
+				}
+				return next(c)
+			}
+
+			header.Add(echo.HeaderVary, echo.HeaderAccessControlRequestMethod)
+			header.Add(echo.HeaderVary, echo.HeaderAccessControlRequestHeaders)
+			header.Set(echo.HeaderAccessControlAllowMethods, allowMethods)
+			if allowHeaders != "" {
+				header.Set(echo.HeaderAccessControlAllowHeaders, allowHeaders)
+			}
+			if opts.MaxAge > 0 {
+				header.Set(echo.HeaderAccessControlMaxAge, strconv.Itoa(int(opts.MaxAge.Seconds())))
+			}
+			return c.NoContent(http.StatusNoContent)
+		}
+	}
+}
+
+// allowOrigin returns the value of Access-Control-Allow-Origin for a request
+// from origin, and whether the origin is allowed at all.
+func (opts CORSOptions) allowOrigin(origin string) (string, bool) {
+	if origin == "" {
+		return "", false
+	}
+	for _, allowed := range opts.AllowedOrigins {
+		if allowed == "*" && !opts.AllowCredentials {
+			return "*", true
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+// WithCORS lets browsers call the API as configured by opts. It installs
+// CORSMiddleware before any other middleware and adds an OPTIONS route to
+// every path of the spec for the preflight requests.
+func WithCORS(opts CORSOptions) RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.cors = CORSMiddleware(opts)
+	}
+}
+
+// preflightHandler handles the OPTIONS routes added by WithCORS, which
+// CORSMiddleware answers before they reach it for preflight requests.
+func preflightHandler(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+// specMethods returns the methods of the operations of the spec.
+func specMethods() []string {
+	var methods []string
+	seen := make(map[string]bool)
+	for _, op := range Operations() {
+		if !seen[op.Method] {
+			seen[op.Method] = true
+			methods = append(methods, op.Method)
+		}
+	}
+	return methods
+}
+
+// specPaths returns the paths of the spec as Echo route paths, e.g.
+// /order/:id for /order/{id}.
+func specPaths() []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, op := range Operations() {
+		if !seen[op.Path] {
+			seen[op.Path] = true
+			paths = append(paths, strings.NewReplacer("{", ":", "}", "").Replace(op.Path))
+		}
+	}
+	return paths
+}
+
+// specHeaders returns the headers of the spec, each once, in the order they
+// first appear in: the request headers, i.e. Content-Type, the headers of the
+// API key security schemes and the header parameters of the operations, and
+// the response headers of the operations and X-API-Version, which
+// VersionMiddleware sets.
+func specHeaders() (request, response []string) {
+	add := func(headers []string, seen map[string]bool, name string) []string {
+		if key := http.CanonicalHeaderKey(name); !seen[key] {
+			seen[key] = true
+			headers = append(headers, name)
+		}
+		return headers
+	}
+	seenRequest, seenResponse := make(map[string]bool), make(map[string]bool)
+
+	request = add(request, seenRequest, echo.HeaderContentType)
+	if swagger, err := GetSwagger(); err == nil {
+		names := make([]string, 0, len(swagger.Components.SecuritySchemes))
+		for name := range swagger.Components.SecuritySchemes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			scheme := swagger.Components.SecuritySchemes[name].Value
+			if scheme != nil && scheme.Type == "apiKey" && scheme.In == openapi3.ParameterInHeader {
+				request = add(request, seenRequest, scheme.Name)
+			}
+		}
+	}
+
+	for _, info := range Operations() {
+		op := findOperation(info.Method, info.Path)
+		if op == nil {
+			continue
+		}
+		for _, ref := range op.spec.Parameters {
+			if param := ref.Value; param != nil && param.In == openapi3.ParameterInHeader {
+				request = add(request, seenRequest, param.Name)
+			}
+		}
+
+		statuses := make([]string, 0, len(op.spec.Responses))
+		for status := range op.spec.Responses {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+		for _, status := range statuses {
+			rsp := op.spec.Responses[status].Value
+			if rsp == nil {
+				continue
+			}
+			names := make([]string, 0, len(rsp.Headers))
+			for name := range rsp.Headers {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				response = add(response, seenResponse, name)
+			}
+		}
+	}
+	response = add(response, seenResponse, APIVersionHeader)
+	return request, response
+}
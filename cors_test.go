@@ -0,0 +1,155 @@
+package spec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func preflight(e *echo.Echo, origin, method, headers string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodOptions, "/order/234578", nil)
+	req.Header.Set(echo.HeaderOrigin, origin)
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, method)
+	if headers != "" {
+		req.Header.Set(echo.HeaderAccessControlRequestHeaders, headers)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// headerList returns the comma-separated values of the header name of rsp,
+// lower-cased, so that header names compare case-insensitively.
+func headerList(rsp *httptest.ResponseRecorder, name string) map[string]bool {
+	list := make(map[string]bool)
+	for _, value := range strings.Split(rsp.Header().Get(name), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list[strings.ToLower(value)] = true
+		}
+	}
+	return list
+}
+
+func TestCORSPreflight(t *testing.T) {
+	e := echo.New()
+	err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithCORS(CORSOptions{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsp := preflight(e, "https://app.example.com", http.MethodPut, "content-type,x-api-key")
+	if rsp.Code != http.StatusNoContent {
+		t.Fatalf("got status %d, want 204", rsp.Code)
+	}
+	for name, want := range map[string]string{
+		echo.HeaderAccessControlAllowOrigin:      "https://app.example.com",
+		echo.HeaderAccessControlAllowCredentials: "true",
+		echo.HeaderAccessControlMaxAge:           "600",
+	} {
+		if got := rsp.Header().Get(name); got != want {
+			t.Errorf("got %s %q, want %q", name, got, want)
+		}
+	}
+	methods := headerList(rsp, echo.HeaderAccessControlAllowMethods)
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		if !methods[strings.ToLower(method)] {
+			t.Errorf("%s does not allow %s", echo.HeaderAccessControlAllowMethods, method)
+		}
+	}
+	headers := headerList(rsp, echo.HeaderAccessControlAllowHeaders)
+	for _, header := range []string{echo.HeaderContentType, APIKeyHeader, "If-Match", IdempotencyKeyHeader, "Prefer"} {
+		if !headers[strings.ToLower(header)] {
+			t.Errorf("%s does not allow %s", echo.HeaderAccessControlAllowHeaders, header)
+		}
+	}
+	vary := rsp.Header().Values(echo.HeaderVary)
+	if strings.Join(vary, ",") != "Origin,Access-Control-Request-Method,Access-Control-Request-Headers" {
+		t.Errorf("got Vary %v", vary)
+	}
+}
+
+func TestCORSPreflightOtherOrigin(t *testing.T) {
+	e := echo.New()
+	err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithCORS(CORSOptions{
+		AllowedOrigins:   []string{"*", "https://app.example.com"},
+		AllowCredentials: true,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With credentials, "*" doesn't allow any origin.
+	rsp := preflight(e, "https://evil.example.com", http.MethodDelete, "")
+	if rsp.Code != http.StatusNoContent {
+		t.Fatalf("got status %d, want 204", rsp.Code)
+	}
+	for name, values := range rsp.Header() {
+		if strings.HasPrefix(name, "Access-Control-") {
+			t.Errorf("got %s %v for an origin which is not allowed", name, values)
+		}
+	}
+}
+
+func TestCORSExposedHeaders(t *testing.T) {
+	e := echo.New()
+	err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithCORS(CORSOptions{
+		AllowedOrigins: []string{"*"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	rsp := httptest.NewRecorder()
+	e.ServeHTTP(rsp, req)
+
+	if got := rsp.Header().Get(echo.HeaderAccessControlAllowOrigin); got != "*" {
+		t.Errorf("got %s %q, want *", echo.HeaderAccessControlAllowOrigin, got)
+	}
+	exposed := headerList(rsp, echo.HeaderAccessControlExposeHeaders)
+	for _, header := range []string{"ETag", echo.HeaderLocation, APIVersionHeader, PreferenceAppliedHeader} {
+		if !exposed[strings.ToLower(header)] {
+			t.Errorf("%s does not expose %s", echo.HeaderAccessControlExposeHeaders, header)
+		}
+	}
+}
+
+// TestCORSConditionalRequests checks that browsers may make the conditional
+// requests of GetOrderId, which need their validators exposed.
+func TestCORSConditionalRequests(t *testing.T) {
+	e := echo.New()
+	err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithCORS(CORSOptions{
+		AllowedOrigins: []string{"*"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsp := preflight(e, "https://app.example.com", http.MethodGet, "if-modified-since,if-none-match")
+	headers := headerList(rsp, echo.HeaderAccessControlAllowHeaders)
+	for _, header := range []string{"If-Modified-Since", "If-None-Match"} {
+		if !headers[strings.ToLower(header)] {
+			t.Errorf("%s does not allow %s", echo.HeaderAccessControlAllowHeaders, header)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/order/234578", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	exposed := headerList(rec, echo.HeaderAccessControlExposeHeaders)
+	for _, header := range []string{"Last-Modified", "ETag"} {
+		if !exposed[strings.ToLower(header)] {
+			t.Errorf("%s does not expose %s", echo.HeaderAccessControlExposeHeaders, header)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AllCurrencies returns every valid Currency, in the order of the spec.
+func AllCurrencies() []Currency {
+	return []Currency{
+		CurrencyCHF,
+		CurrencyEUR,
+		CurrencyGBP,
+		CurrencyJPY,
+		CurrencyUSD,
+	}
+}
+
+// IsValid reports whether c is one of the currencies allowed by the spec.
+func (c Currency) IsValid() bool {
+	for _, currency := range AllCurrencies() {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting currencies which are
+// not allowed by the spec.
+func (c *Currency) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if !Currency(s).IsValid() {
+		return fmt.Errorf("invalid currency %q", s)
+	}
+	*c = Currency(s)
+	return nil
+}
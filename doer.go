@@ -0,0 +1,41 @@
+package spec
+
+// doerLayer is a Doer which a ClientOption wraps around the Doer of the
+// client, like the one of WithRetry. Options only record their layer, and
+// NewClient wraps them around the Doer once every option is applied, so that
+// they don't depend on the order of the options, and WithHTTPClient in
+// particular can come anywhere.
+type doerLayer int
+
+// The layers, from the innermost, closest to the network, to the outermost,
+// which a call of the client goes through first. So a request is traced
+// once, however it is answered; cache hits aren't observed, as they make no
+// request; and the observer sees the outcome of the retries, while every
+// retry is compressed.
+const (
+	compressionLayer doerLayer = iota
+	retryLayer
+	observerLayer
+	cacheLayer
+	tracingLayer
+	layerCount
+)
+
+// setLayer makes wrap the layer of the client at the position of layer,
+// replacing the one an earlier option set there, if any.
+func (c *Client) setLayer(layer doerLayer, wrap func(doer HttpRequestDoer) HttpRequestDoer) {
+	if c.layers == nil {
+		c.layers = make([]func(HttpRequestDoer) HttpRequestDoer, layerCount)
+	}
+	c.layers[layer] = wrap
+}
+
+// wrapLayers wraps the layers set by the options of the client around its
+// Doer.
+func (c *Client) wrapLayers() {
+	for _, wrap := range c.layers {
+		if wrap != nil {
+			c.Client = wrap(c.Client)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// errorCodes are the codes of Errors for statuses whose code is not derived
+// from the status text, like not_found for 404.
+var errorCodes = map[int]string{
+	http.StatusBadRequest:            "invalid_request",
+	http.StatusRequestEntityTooLarge: "payload_too_large",
+	http.StatusInternalServerError:   "internal_error",
+}
+
+// NewHTTPErrorHandler returns an echo.HTTPErrorHandler which responds to
+// errors with an Error, like the handlers of the spec do:
+//
+//   - an *echo.HTTPError, as Echo returns for unknown routes or bodies which
+//     can't be bound, with its status and message, or with its message if it
+//     is an Error,
+//   - a validation error of the spec with 400 and every offending field,
+//   - any other error with 500, without its message, which may reveal
+//     internals.
+//
+// An error after the response is committed, like one of ExportOrders midway,
+// which WriteOrdersNDJSON already ended the body with an Error for, can't be
+// responded to any more and is only logged.
+//
+// RegisterHandlersWithOptions installs it on the routes of the spec. Set it as
+// the HTTPErrorHandler of an echo.Echo to cover its other routes too.
+func NewHTTPErrorHandler() echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			c.Logger().Error(err)
+			return
+		}
+
+		status, body := errorResponse(err)
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(status)
+		} else {
+			err = c.JSON(status, body)
+		}
+		if err != nil {
+			c.Logger().Error(err)
+		}
+	}
+}
+
+// errorResponse returns the status and Error to respond to err with.
+func errorResponse(err error) (int, Error) {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		body := Error{Code: errorCode(httpErr.Code), Message: http.StatusText(httpErr.Code)}
+		switch m := httpErr.Message.(type) {
+		case Error:
+			body = m
+		case string:
+			body.Message = m
+		case error:
+			body.Message = m.Error()
+		case nil:
+		default:
+			body.Message = fmt.Sprint(m)
+		}
+		return httpErr.Code, body
+	}
+
+	if details := validationDetails(err); len(details) > 0 {
+		return http.StatusBadRequest, Error{
+			Code:    errorCode(http.StatusBadRequest),
+			Message: "the request does not conform to the spec",
+			Details: &details,
+		}
+	}
+
+	return http.StatusInternalServerError, Error{
+		Code:    errorCode(http.StatusInternalServerError),
+		Message: "the server failed to handle the request",
+	}
+}
+
+// errorCode returns the code of Errors with status, e.g. not_found for 404.
+func errorCode(status int) string {
+	if code, ok := errorCodes[status]; ok {
+		return code
+	}
+	if text := http.StatusText(status); text != "" {
+		return strings.ReplaceAll(strings.ToLower(text), " ", "_")
+	}
+	return "error"
+}
+
+// WithErrorHandler makes the routes of the spec respond to the errors of
+// handlers and middleware with h instead of NewHTTPErrorHandler. A nil h
+// leaves the errors to the HTTPErrorHandler of the echo.Echo.
+func WithErrorHandler(h echo.HTTPErrorHandler) RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.errorHandler = h
+	}
+}
+
+// handleErrors returns a middleware which responds to the errors of the
+// rest of the chain with h, panics included, so that they never reach the
+// HTTPErrorHandler of the echo.Echo. A panic is logged and responded to like
+// an error, with 500.
+func handleErrors(h echo.HTTPErrorHandler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					if r == http.ErrAbortHandler {
+						panic(r)
+					}
+					err = fmt.Errorf("panic: %v", r)
+					c.Logger().Error(err)
+				}
+				if err != nil {
+					h(err, c)
+					err = nil
+				}
+			}()
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	exampleSchemasOnce sync.Once
+	exampleSchemas     openapi3.Schemas
+)
+
+// example returns an example of the schema name of the spec, built from the
+// examples of the schema and its properties. Where the spec has none, it
+// falls back to a deterministic value which conforms to the schema, e.g. the
+// first value of an enum or the minimum of a number. The example is checked
+// against the schema, so it panics if the spec and T drifted apart.
+func example[T any](name string) T {
+	exampleSchemasOnce.Do(func() {
+		if swagger, err := GetSwagger(); err == nil {
+			exampleSchemas = swagger.Components.Schemas
+		}
+	})
+	ref := exampleSchemas[name]
+	if ref == nil || ref.Value == nil {
+		panic(fmt.Sprintf("spec: no schema %s", name))
+	}
+
+	value := exampleValue(ref.Value)
+	if err := ref.Value.VisitJSON(value); err != nil {
+		panic(fmt.Sprintf("spec: example of %s does not conform to it: %s", name, err))
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Sprintf("spec: example of %s: %s", name, err))
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		panic(fmt.Sprintf("spec: example of %s: %s", name, err))
+	}
+	return v
+}
+
+// exampleValue returns the example of schema, or a value which conforms to
+// it if it has none, as decoded from JSON.
+func exampleValue(schema *openapi3.Schema) interface{} {
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+
+	switch schema.Type {
+	case "object":
+		obj := make(map[string]interface{}, len(schema.Properties))
+		for name, property := range schema.Properties {
+			obj[name] = exampleValue(property.Value)
+		}
+		return obj
+	case "array":
+		items := make([]interface{}, 1)
+		if schema.MinItems > 1 {
+			items = make([]interface{}, schema.MinItems)
+		}
+		for i := range items {
+			items[i] = exampleValue(schema.Items.Value)
+		}
+		return items
+	case "integer", "number":
+		n := 1.0
+		if schema.Min != nil {
+			n = *schema.Min
+			if schema.ExclusiveMin {
+				n++
+			}
+		}
+		return n
+	case "string":
+		s := "example"
+		if n := int(schema.MinLength); n > len(s) {
+			s += strings.Repeat("e", n-len(s))
+		}
+		return s
+	case "boolean":
+		return true
+	}
+	return nil
+}
+
+// ExampleOrder returns an example Order.
+func ExampleOrder() Order { return example[Order]("Order") }
+
+// ExampleOrderInput returns an example OrderInput.
+func ExampleOrderInput() OrderInput { return example[OrderInput]("OrderInput") }
+
+// ExampleOrderPatch returns an example OrderPatch.
+func ExampleOrderPatch() OrderPatch { return example[OrderPatch]("OrderPatch") }
+
+// ExampleOrderReplacement returns an example OrderReplacement.
+func ExampleOrderReplacement() OrderReplacement {
+	return example[OrderReplacement]("OrderReplacement")
+}
+
+// ExampleOrderForm returns an example OrderForm.
+func ExampleOrderForm() OrderForm { return example[OrderForm]("OrderForm") }
+
+// ExampleOrderList returns an example OrderList.
+func ExampleOrderList() OrderList { return example[OrderList]("OrderList") }
+
+// ExampleMoney returns an example Money.
+func ExampleMoney() Money { return example[Money]("Money") }
+
+// ExampleCreatedOrder returns an example CreatedOrder.
+func ExampleCreatedOrder() CreatedOrder { return example[CreatedOrder]("CreatedOrder") }
+
+// ExampleBatchCreateOrdersOutput returns an example BatchCreateOrdersOutput.
+func ExampleBatchCreateOrdersOutput() BatchCreateOrdersOutput {
+	return example[BatchCreateOrdersOutput]("BatchCreateOrdersOutput")
+}
+
+// ExampleError returns an example Error.
+func ExampleError() Error { return example[Error]("Error") }
+
+// ExampleHealth returns an example Health.
+func ExampleHealth() Health { return example[Health]("Health") }
+
+// ExampleVersion returns an example Version.
+func ExampleVersion() Version { return example[Version]("Version") }
+
+// ExamplePostOrderJSONRequestBody returns an example body of PostOrder.
+func ExamplePostOrderJSONRequestBody() PostOrderJSONRequestBody {
+	return PostOrderJSONRequestBody(ExampleOrderInput())
+}
+
+// ExamplePutOrderIdJSONRequestBody returns an example body of PutOrderId.
+func ExamplePutOrderIdJSONRequestBody() PutOrderIdJSONRequestBody {
+	return PutOrderIdJSONRequestBody(ExampleOrderReplacement())
+}
+
+// ExamplePutOrderIdFormdataRequestBody returns an example form body of
+// PutOrderId.
+func ExamplePutOrderIdFormdataRequestBody() PutOrderIdFormdataRequestBody {
+	return PutOrderIdFormdataRequestBody(ExampleOrderForm())
+}
+
+// ExamplePatchOrderIdJSONRequestBody returns an example body of PatchOrderId.
+func ExamplePatchOrderIdJSONRequestBody() PatchOrderIdJSONRequestBody {
+	return PatchOrderIdJSONRequestBody(ExampleOrderPatch())
+}
+
+// ExampleBatchCreateOrdersJSONRequestBody returns an example body of
+// BatchCreateOrders.
+func ExampleBatchCreateOrdersJSONRequestBody() BatchCreateOrdersJSONRequestBody {
+	return example[BatchCreateOrdersJSONRequestBody]("BatchCreateOrdersInput")
+}
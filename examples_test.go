@@ -0,0 +1,96 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestExamples(t *testing.T) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		schema  string
+		example func() interface{}
+	}{
+		{"Order", func() interface{} { return ExampleOrder() }},
+		{"OrderInput", func() interface{} { return ExampleOrderInput() }},
+		{"OrderPatch", func() interface{} { return ExampleOrderPatch() }},
+		{"OrderReplacement", func() interface{} { return ExampleOrderReplacement() }},
+		{"OrderForm", func() interface{} { return ExampleOrderForm() }},
+		{"OrderList", func() interface{} { return ExampleOrderList() }},
+		{"Money", func() interface{} { return ExampleMoney() }},
+		{"CreatedOrder", func() interface{} { return ExampleCreatedOrder() }},
+		{"BatchCreateOrdersOutput", func() interface{} { return ExampleBatchCreateOrdersOutput() }},
+		{"Error", func() interface{} { return ExampleError() }},
+		{"Health", func() interface{} { return ExampleHealth() }},
+		{"Version", func() interface{} { return ExampleVersion() }},
+		{"OrderInput", func() interface{} { return ExamplePostOrderJSONRequestBody() }},
+		{"OrderReplacement", func() interface{} { return ExamplePutOrderIdJSONRequestBody() }},
+		{"OrderForm", func() interface{} { return ExamplePutOrderIdFormdataRequestBody() }},
+		{"OrderPatch", func() interface{} { return ExamplePatchOrderIdJSONRequestBody() }},
+		{"BatchCreateOrdersInput", func() interface{} { return ExampleBatchCreateOrdersJSONRequestBody() }},
+	} {
+		ref := swagger.Components.Schemas[test.schema]
+		if ref == nil {
+			t.Fatalf("no schema %s", test.schema)
+		}
+		value := roundTrip(t, test.example())
+		if err := ref.Value.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+			t.Errorf("the example of %s does not conform to it: %s", test.schema, err)
+		}
+	}
+}
+
+// TestExamplePrices checks that the examples with a price and a total
+// relate them as the spec does.
+func TestExamplePrices(t *testing.T) {
+	order, input, replacement, patch := ExampleOrder(), ExampleOrderInput(), ExampleOrderReplacement(), ExampleOrderPatch()
+	form := ExampleOrderForm()
+	var formTotal *Money
+	if form.Amount != nil && form.Currency != nil {
+		formTotal = &Money{Amount: *form.Amount, Currency: *form.Currency}
+	}
+	for name, example := range map[string]struct {
+		price *int
+		total *Money
+	}{
+		"Order":            {order.Price, order.Total},
+		"OrderInput":       {input.Price, input.Total},
+		"OrderReplacement": {replacement.Price, replacement.Total},
+		"OrderPatch":       {patch.Price, patch.Total},
+		"OrderForm":        {form.Price, formTotal},
+	} {
+		if _, _, invalid := reconcilePrice(example.price, example.total); invalid != nil {
+			t.Errorf("the price and total of the example of %s disagree", name)
+		}
+	}
+}
+
+// TestExampleBatchResult checks that the example result of a batch is either
+// a created order or an error, as a result is.
+func TestExampleBatchResult(t *testing.T) {
+	for _, result := range ExampleBatchCreateOrdersOutput().Results {
+		if (result.Id == nil) == (result.Error == nil) {
+			t.Errorf("got the example result %+v, want an ID or an error", result)
+		}
+	}
+}
+
+// roundTrip returns v as decoded from its JSON, for VisitJSON.
+func roundTrip(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
@@ -0,0 +1,125 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// MIMEApplicationNDJSON is the content type of ExportOrders, newline-delimited
+// JSON.
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+// maxExportErrorBody is how much of the body of a failed export is read for
+// its Error.
+const maxExportErrorBody = 64 << 10
+
+// ExportError is the Error an export of orders failed with.
+type ExportError struct {
+	// StatusCode is the status of the response, 200 if the export failed
+	// after it started.
+	StatusCode int
+	Body       Error
+}
+
+func (e *ExportError) Error() string {
+	return fmt.Sprintf("export failed with status %d: %s: %s", e.StatusCode, e.Body.Code, e.Body.Message)
+}
+
+// WriteOrdersNDJSON writes orders to w as the body of ExportOrders, one per
+// line, as they come. If orders fails before the first order, nothing is
+// written and the error is returned, to be responded to like any other. If it
+// fails later, an Error is written as the last line, so that the client
+// doesn't take the export for complete, and the error is returned too.
+func WriteOrdersNDJSON(w http.ResponseWriter, orders iter.Seq2[Order, error]) error {
+	enc := json.NewEncoder(w)
+	started := false
+	start := func() {
+		if !started {
+			w.Header().Set("Content-Type", MIMEApplicationNDJSON)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+	}
+
+	for order, err := range orders {
+		if err != nil {
+			if !started {
+				return err
+			}
+			if encErr := enc.Encode(Error{
+				Code:    "export_failed",
+				Message: "the export failed before every order was written",
+			}); encErr != nil {
+				return encErr
+			}
+			return err
+		}
+		start()
+		if err := enc.Encode(order); err != nil {
+			return err
+		}
+	}
+	start()
+	return nil
+}
+
+// ExportOrdersSeq exports every order, decoding them one at a time as they
+// are ranged over, rather than reading the whole body like
+// ExportOrdersWithResponse. A response other than 200 is returned as an
+// *ExportError, and so is a failure of the server midway, as the last
+// element. The orders can only be ranged over once, which closes the body of
+// the response, so they must be.
+func (c *ClientWithResponses) ExportOrdersSeq(ctx context.Context, reqEditors ...RequestEditorFn) (iter.Seq2[Order, error], error) {
+	rsp, err := c.ExportOrders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode != http.StatusOK {
+		defer rsp.Body.Close()
+		exportErr := &ExportError{
+			StatusCode: rsp.StatusCode,
+			Body:       Error{Code: errorCode(rsp.StatusCode), Message: http.StatusText(rsp.StatusCode)},
+		}
+		body, err := io.ReadAll(io.LimitReader(rsp.Body, maxExportErrorBody))
+		if err != nil {
+			return nil, err
+		}
+		// A body which isn't an Error leaves the one derived from the status.
+		_ = json.Unmarshal(body, &exportErr.Body)
+		return nil, exportErr
+	}
+
+	return func(yield func(Order, error) bool) {
+		defer rsp.Body.Close()
+		dec := json.NewDecoder(rsp.Body)
+		for {
+			// A line is an order, or an Error if the export failed.
+			var line struct {
+				Order
+				Code    string    `json:"code"`
+				Message string    `json:"message"`
+				Details *[]string `json:"details"`
+			}
+			if err := dec.Decode(&line); err == io.EOF {
+				return
+			} else if err != nil {
+				yield(Order{}, err)
+				return
+			}
+			if line.Code != "" {
+				yield(Order{}, &ExportError{
+					StatusCode: rsp.StatusCode,
+					Body:       Error{Code: line.Code, Message: line.Message, Details: line.Details},
+				})
+				return
+			}
+			if !yield(line.Order, nil) {
+				return
+			}
+		}
+	}, nil
+}
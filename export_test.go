@@ -0,0 +1,104 @@
+package spec
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// failingExportServer exports the orders of its store, and then fails
+// before the export is complete.
+type failingExportServer struct {
+	ServerInterface
+	orders []Order
+}
+
+func (s failingExportServer) ExportOrders(c echo.Context) error {
+	return WriteOrdersNDJSON(c.Response(), func(yield func(Order, error) bool) {
+		for _, order := range s.orders {
+			if !yield(order, nil) {
+				return
+			}
+		}
+		yield(Order{}, errors.New("the store went away"))
+	})
+}
+
+// TestExportFailsMidway checks that an export which fails after the 200 has
+// been written ends with an Error line, rather than just being cut off, and
+// that ExportOrdersSeq returns it after the orders which came before.
+func TestExportFailsMidway(t *testing.T) {
+	green, red := OrderItemTeaTableGreen, OrderItemTeaTableRed
+	orders := []Order{
+		{Id: stringPtr("234578"), Item: &green},
+		{Id: stringPtr("234579"), Item: &red},
+	}
+	e := echo.New()
+	e.Logger.SetOutput(io.Discard)
+	if err := RegisterHandlersWithOptions(e, failingExportServer{NewInMemoryStore(), orders}); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	rsp, err := http.Get(server.URL + "/orders/export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK || rsp.Header.Get(echo.HeaderContentType) != MIMEApplicationNDJSON {
+		t.Fatalf("got status %d and content type %q, want 200 and %s",
+			rsp.StatusCode, rsp.Header.Get(echo.HeaderContentType), MIMEApplicationNDJSON)
+	}
+	var lines []string
+	scanner := bufio.NewScanner(rsp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != len(orders)+1 {
+		t.Fatalf("got the lines %q, want the %d orders and an Error", lines, len(orders))
+	}
+	var last Error
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &last); err != nil || last.Code != "export_failed" {
+		t.Errorf("got the last line %s, want an export_failed Error", lines[len(lines)-1])
+	}
+
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq, err := client.ExportOrdersSeq(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []Order
+	var exportErr *ExportError
+	for order, err := range seq {
+		if err != nil {
+			if !errors.As(err, &exportErr) {
+				t.Fatalf("got the error %v, want an *ExportError", err)
+			}
+			continue
+		}
+		got = append(got, order)
+	}
+	if len(got) != len(orders) {
+		t.Errorf("got %d orders before the error, want %d", len(got), len(orders))
+	}
+	if exportErr == nil {
+		t.Fatal("got no error at the end of the export")
+	}
+	if exportErr.StatusCode != http.StatusOK || exportErr.Body.Code != "export_failed" {
+		t.Errorf("got the error %v, want export_failed with status 200", exportErr)
+	}
+}
@@ -0,0 +1,68 @@
+package spec
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+var (
+	orderFieldsOnce sync.Once
+	orderFields     map[string]bool
+)
+
+// unknownOrderFields returns the names in fields, the fields parameter of
+// GetOrderId, which are not fields of an Order.
+func unknownOrderFields(fields []string) []string {
+	orderFieldsOnce.Do(func() {
+		swagger, err := GetSwagger()
+		if err != nil {
+			return
+		}
+		if ref := swagger.Components.Schemas["Order"]; ref != nil {
+			orderFields = make(map[string]bool, len(ref.Value.Properties))
+			for name := range ref.Value.Properties {
+				orderFields[name] = true
+			}
+		}
+	})
+
+	var unknown []string
+	for _, name := range fields {
+		if name = strings.TrimSpace(name); name != "" && !orderFields[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// selectOrderFields returns the JSON fields of order named by fields, or the
+// whole order if fields names none, so that fields= means all fields rather
+// than none.
+func selectOrderFields(order Order, fields []string) (interface{}, error) {
+	selected := make(map[string]bool, len(fields))
+	for _, name := range fields {
+		if name = strings.TrimSpace(name); name != "" {
+			selected[name] = true
+		}
+	}
+	if len(selected) == 0 {
+		return order, nil
+	}
+
+	b, err := json.Marshal(order)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	sparse := make(map[string]json.RawMessage, len(selected))
+	for name := range selected {
+		if value, ok := all[name]; ok {
+			sparse[name] = value
+		}
+	}
+	return sparse, nil
+}
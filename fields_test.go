@@ -0,0 +1,75 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestGetOrderIdFields pins the sparse fieldsets of GetOrderId: an empty
+// fields means all of them, and unknown names are rejected with 400, with
+// and without WithValidation.
+func TestGetOrderIdFields(t *testing.T) {
+	const order = `{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}`
+	for _, validate := range []bool{false, true} {
+		var opts []RegisterOption
+		if validate {
+			opts = append(opts, WithValidation())
+		}
+		e := echo.New()
+		if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), opts...); err != nil {
+			t.Fatal(err)
+		}
+		serve := func(method, target, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, target, strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec
+		}
+		if rec := serve(http.MethodPut, "/order/234578", `{"item":"Tea Table Green","price":1499}`); rec.Code != http.StatusCreated {
+			t.Fatalf("got status %d creating the order, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+		}
+
+		for _, tt := range []struct {
+			query       string
+			wantStatus  int
+			wantBody    string
+			wantDetails []string
+		}{
+			{"", http.StatusOK, order, nil},
+			{"?fields=", http.StatusOK, order, nil},
+			{"?fields=,", http.StatusOK, order, nil},
+			{"?fields=price", http.StatusOK, `{"price":1499}`, nil},
+			{"?fields=price,item", http.StatusOK, `{"item":"Tea Table Green","price":1499}`, nil},
+			{"?fields=,price,", http.StatusOK, `{"price":1499}`, nil},
+			{"?fields=prise", http.StatusBadRequest, "", []string{"fields: unknown field prise"}},
+			{"?fields=price,prise,colour", http.StatusBadRequest, "",
+				[]string{"fields: unknown field prise", "fields: unknown field colour"}},
+		} {
+			rec := serve(http.MethodGet, "/order/234578"+tt.query, "")
+			if rec.Code != tt.wantStatus {
+				t.Errorf("validate %t, %q: got status %d, want %d: %s", validate, tt.query, rec.Code, tt.wantStatus, rec.Body)
+				continue
+			}
+			if tt.wantStatus == http.StatusOK {
+				if got := strings.TrimSpace(rec.Body.String()); got != tt.wantBody {
+					t.Errorf("validate %t, %q: got %s, want %s", validate, tt.query, got, tt.wantBody)
+				}
+				continue
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Code != "invalid_fields" || rsp.Details == nil ||
+				strings.Join(*rsp.Details, "; ") != strings.Join(tt.wantDetails, "; ") {
+				t.Errorf("validate %t, %q: got the Error %s, want invalid_fields with %q", validate, tt.query, rec.Body, tt.wantDetails)
+			}
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+// oapi-codegen only generates JSON bodies, so this file provides the
+// application/x-www-form-urlencoded body of PutOrderId the way it would.
+
+// PutOrderIdFormdataBody defines parameters for PutOrderId.
+type PutOrderIdFormdataBody OrderForm
+
+// PutOrderIdFormdataRequestBody defines body for PutOrderId for application/x-www-form-urlencoded ContentType.
+type PutOrderIdFormdataRequestBody PutOrderIdFormdataBody
+
+// NewPutOrderIdRequestWithFormdataBody calls the generic PutOrderId builder with application/x-www-form-urlencoded body
+func NewPutOrderIdRequestWithFormdataBody(server string, id string, params *PutOrderIdParams, body PutOrderIdFormdataRequestBody) (*http.Request, error) {
+	return NewPutOrderIdRequestWithBody(server, id, params, echo.MIMEApplicationForm, encodeOrderForm(body))
+}
+
+func (c *Client) PutOrderIdWithFormdataBody(ctx context.Context, id string, params *PutOrderIdParams, body PutOrderIdFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	return c.PutOrderIdWithBody(ctx, id, params, echo.MIMEApplicationForm, encodeOrderForm(body), reqEditors...)
+}
+
+// PutOrderIdWithFormdataBodyWithResponse request with application/x-www-form-urlencoded body returning *PutOrderIdResponse
+func (c *ClientWithResponses) PutOrderIdWithFormdataBodyWithResponse(ctx context.Context, id string, params *PutOrderIdParams, body PutOrderIdFormdataRequestBody, reqEditors ...RequestEditorFn) (*PutOrderIdResponse, error) {
+	rsp, err := c.PutOrderIdWithBody(ctx, id, params, echo.MIMEApplicationForm, encodeOrderForm(body), reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrderIdResponse(rsp)
+}
+
+func encodeOrderForm(body PutOrderIdFormdataRequestBody) io.Reader {
+	form := url.Values{}
+	if body.Id != nil {
+		form.Set("id", *body.Id)
+	}
+	form.Set("item", string(body.Item))
+	if body.Price != nil {
+		form.Set("price", strconv.Itoa(*body.Price))
+	}
+	if body.Amount != nil {
+		form.Set("amount", strconv.FormatInt(*body.Amount, 10))
+	}
+	if body.Currency != nil {
+		form.Set("currency", string(*body.Currency))
+	}
+	return strings.NewReader(form.Encode())
+}
+
+// bindPutOrderIdBody decodes the body of a PutOrderId request, from JSON or
+// from a form depending on its Content-Type. Form values are checked like
+// JSON ones, so an invalid item or price is rejected with 400 either way, and
+// so is a body without item or total, when it has no price either, with an
+// Error listing the missing ones. The amount and currency of a form make up
+// its total.
+func bindPutOrderIdBody(c echo.Context) (PutOrderIdJSONRequestBody, error) {
+	var body PutOrderIdJSONRequestBody
+	var missing []string
+	if !strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm) {
+		// Order has pointers, so that absent fields can be told from zero
+		// ones.
+		var order Order
+		if err := c.Bind(&order); err != nil {
+			return body, err
+		}
+		body.Id, body.Price, body.Total = order.Id, order.Price, order.Total
+		if order.Item != nil {
+			body.Item = *order.Item
+		} else {
+			missing = append(missing, "item")
+		}
+		if order.Price == nil && order.Total == nil {
+			missing = append(missing, "total")
+		}
+		return body, missingFields(missing)
+	}
+
+	form, err := c.FormParams()
+	if err != nil {
+		return body, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+	if form.Has("id") {
+		id := form.Get("id")
+		body.Id = &id
+	}
+	if form.Has("item") {
+		item, err := ParseOrderItem(form.Get("item"))
+		if err != nil {
+			return body, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+		body.Item = item
+	} else {
+		missing = append(missing, "item")
+	}
+	if form.Has("price") {
+		price, err := strconv.Atoi(form.Get("price"))
+		if err != nil {
+			err = fmt.Errorf("invalid price %q", form.Get("price"))
+			return body, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+		body.Price = &price
+	}
+	if form.Has("amount") || form.Has("currency") {
+		var total Money
+		if form.Has("amount") {
+			total.Amount, err = strconv.ParseInt(form.Get("amount"), 10, 64)
+			if err != nil {
+				err = fmt.Errorf("invalid amount %q", form.Get("amount"))
+				return body, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+		} else {
+			missing = append(missing, "amount")
+		}
+		if form.Has("currency") {
+			total.Currency = Currency(form.Get("currency"))
+			if !total.Currency.IsValid() {
+				err = fmt.Errorf("invalid currency %q", form.Get("currency"))
+				return body, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+		} else {
+			missing = append(missing, "currency")
+		}
+		body.Total = &total
+	} else if body.Price == nil {
+		missing = append(missing, "amount", "currency")
+	}
+	return body, missingFields(missing)
+}
+
+// missingFields returns an error responded to with 400 and an Error listing
+// the required fields which are missing, or nil if none are.
+func missingFields(missing []string) error {
+	if len(missing) == 0 {
+		return nil
+	}
+	details := make([]string, len(missing))
+	for i, field := range missing {
+		details[i] = fmt.Sprintf("%s: property %q is missing", field, field)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, Error{
+		Code:    "invalid_request",
+		Message: "the order lacks required fields",
+		Details: &details,
+	})
+}
+
+// isFormBody reports whether req has a form body, going by its Content-Type.
+func isFormBody(req *http.Request) bool {
+	return strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm)
+}
+
+// validateFormBody validates the form body of req against the schema op
+// declares for forms, like openapi3filter validates JSON bodies, and puts the
+// body back. Unlike the form decoder of kin-openapi, which sets absent fields
+// to null and so fails the validation of every optional field which is not
+// nullable, it treats absent fields as absent. The decoder is used as is
+// rather than replaced, as it is shared by every user of openapi3filter.
+func validateFormBody(req *http.Request, op *openapi3.Operation) error {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil
+	}
+	media := op.RequestBody.Value.Content.Get(echo.MIMEApplicationForm)
+	decode := openapi3filter.RegisteredBodyDecoder(echo.MIMEApplicationForm)
+	if media == nil || media.Schema == nil || decode == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	value, err := decode(bytes.NewReader(body), req.Header, media.Schema, nil)
+	if err != nil {
+		return &openapi3filter.RequestError{
+			Input:       &openapi3filter.RequestValidationInput{Request: req},
+			RequestBody: op.RequestBody.Value,
+			Reason:      "failed to decode request body",
+			Err:         err,
+		}
+	}
+	if obj, ok := value.(map[string]interface{}); ok {
+		for name, v := range obj {
+			if v == nil {
+				delete(obj, name)
+			}
+		}
+	}
+	if err := media.Schema.Value.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+		return &openapi3filter.RequestError{
+			Input:       &openapi3filter.RequestValidationInput{Request: req},
+			RequestBody: op.RequestBody.Value,
+			Reason:      "doesn't match the schema",
+			Err:         err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+func TestFormValidation(t *testing.T) {
+	decoder := reflect.ValueOf(openapi3filter.RegisteredBodyDecoder(echo.MIMEApplicationForm)).Pointer()
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithValidation()); err != nil {
+		t.Fatal(err)
+	}
+	if reflect.ValueOf(openapi3filter.RegisteredBodyDecoder(echo.MIMEApplicationForm)).Pointer() != decoder {
+		t.Error("the form decoder of openapi3filter was replaced")
+	}
+
+	for _, tt := range []struct {
+		form    string
+		want    int
+		details []string
+	}{
+		// Absent optional fields, like id, are not null.
+		{"item=Tea+Table+Green&amount=1499&currency=EUR", http.StatusCreated, nil},
+		{"item=Tea+Table+Green&price=1499", http.StatusCreated, nil},
+		{"item=Tea+Table+Blue&price=1499", http.StatusBadRequest, []string{"item: value is not one of the allowed values"}},
+		{"item=Tea+Table+Green&price=0", http.StatusBadRequest, []string{"price: number must be at least 1"}},
+		{"price=1499", http.StatusBadRequest, []string{`item: property "item" is missing`}},
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/order/234578", strings.NewReader(tt.form))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		if rec.Code != tt.want {
+			t.Errorf("%s: got status %d, want %d: %s", tt.form, rec.Code, tt.want, rec.Body)
+			continue
+		}
+		if tt.details == nil {
+			continue
+		}
+		var rsp Error
+		if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+			t.Fatal(err)
+		}
+		if rsp.Details == nil || !reflect.DeepEqual(*rsp.Details, tt.details) {
+			t.Errorf("%s: got details %v, want %v", tt.form, rsp.Details, tt.details)
+		}
+	}
+}
+
+// TestFormClient checks that the form body of PutOrderId can be sent through
+// ClientWithResponsesInterface, as through the JSON one.
+func TestFormClient(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithValidation()); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	var client ClientWithResponsesInterface
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := ExamplePutOrderIdFormdataRequestBody()
+	rsp, err := client.PutOrderIdWithFormdataBodyWithResponse(context.Background(), "234578", &PutOrderIdParams{}, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.StatusCode() != http.StatusCreated {
+		t.Fatalf("got status %d, want %d: %s", rsp.StatusCode(), http.StatusCreated, rsp.Body)
+	}
+
+	got, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JSON200 == nil || got.JSON200.Item == nil || *got.JSON200.Item != body.Item {
+		t.Errorf("got the order %s, want the one of the form", got.Body)
+	}
+}
@@ -0,0 +1,39 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReadinessCheck returns an error describing why the server can't handle
+// requests yet, e.g. because its datastore is down, or nil if it can.
+type ReadinessCheck func(ctx context.Context) error
+
+// Probes implements GetHealthz and GetReadyz, the liveness and readiness
+// probes of ServerInterface. Embed it in an implementation to serve them.
+type Probes struct {
+	// Ready is checked by GetReadyz. A nil Ready reports the server as always
+	// ready.
+	Ready ReadinessCheck
+}
+
+// GetHealthz reports the server as alive, as long as it handles requests.
+func (p Probes) GetHealthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, Health{Status: "ok"})
+}
+
+// GetReadyz reports the server as ready if p.Ready passes, or responds with
+// 503 and the error of p.Ready otherwise.
+func (p Probes) GetReadyz(c echo.Context) error {
+	if p.Ready != nil {
+		if err := p.Ready(c.Request().Context()); err != nil {
+			return c.JSON(http.StatusServiceUnavailable, Error{
+				Code:    "not_ready",
+				Message: err.Error(),
+			})
+		}
+	}
+	return c.JSON(http.StatusOK, Health{Status: "ok"})
+}
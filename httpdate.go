@@ -0,0 +1,39 @@
+package spec
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// HTTPDate is a time sent in an HTTP header, like If-Modified-Since, as an
+// HTTP-date.
+type HTTPDate time.Time
+
+// UnmarshalText implements encoding.TextUnmarshaler. Conditional headers with
+// an invalid date must be ignored, so it decodes one as the zero time, before
+// which everything is modified, rather than failing.
+func (d *HTTPDate) UnmarshalText(text []byte) error {
+	t, err := http.ParseTime(string(text))
+	if err != nil {
+		t = time.Time{}
+	}
+	*d = HTTPDate(t)
+	return nil
+}
+
+// String formats d as an HTTP-date.
+func (d HTTPDate) String() string {
+	return time.Time(d).UTC().Format(http.TimeFormat)
+}
+
+// styleHeaderParam styles value, the header parameter name of a request of the
+// client, like runtime.StyleParamWithLocation, except for an HTTPDate, which
+// it would format as an RFC 3339 time, as runtime does any time.
+func styleHeaderParam(style string, explode bool, name string, value interface{}) (string, error) {
+	if d, ok := value.(HTTPDate); ok {
+		return d.String(), nil
+	}
+	return runtime.StyleParamWithLocation(style, explode, name, runtime.ParamLocationHeader, value)
+}
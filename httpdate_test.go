@@ -0,0 +1,71 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestIfModifiedSinceHeader checks that the client sends If-Modified-Since
+// as an HTTP-date, which is the only format servers and caches understand.
+func TestIfModifiedSinceHeader(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("If-Modified-Since")
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	since := HTTPDate(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if _, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{IfModifiedSince: &since}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Fri, 02 Jan 2026 03:04:05 GMT"; got != want {
+		t.Errorf("got If-Modified-Since %q, want %q", got, want)
+	}
+}
+
+// TestIfModifiedSince checks that the server answers If-Modified-Since as an
+// HTTP-date, and ignores it in any other format.
+func TestIfModifiedSince(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore()); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method, body string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/order/234578", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		for name, value := range header {
+			req.Header.Set(name, value)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := serve(http.MethodPut, `{"item":"Tea Table Green","price":1499}`, nil); rec.Code != http.StatusCreated {
+		t.Fatalf("got status %d for the order, want %d", rec.Code, http.StatusCreated)
+	}
+	later := time.Now().Add(time.Hour)
+
+	for _, tt := range []struct {
+		name, since string
+		want        int
+	}{
+		{"HTTP-date", later.UTC().Format(http.TimeFormat), http.StatusNotModified},
+		{"RFC 3339", later.UTC().Format(time.RFC3339), http.StatusOK},
+		{"invalid", "yesterday", http.StatusOK},
+	} {
+		if rec := serve(http.MethodGet, "", map[string]string{"If-Modified-Since": tt.since}); rec.Code != tt.want {
+			t.Errorf("got status %d for If-Modified-Since %s, want %d", rec.Code, tt.name, tt.want)
+		}
+	}
+}
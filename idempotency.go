@@ -0,0 +1,199 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IdempotencyKeyHeader is the header a client sends a key identifying a
+// request in, so that retrying the request doesn't repeat its effect.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentResponse is a response recorded by WithIdempotency, to be
+// replayed to requests with the same Idempotency-Key.
+type IdempotentResponse struct {
+	// Fingerprint identifies the request the response is for, so that a key
+	// reused for a different request can be told apart from a replay.
+	Fingerprint string
+	StatusCode  int
+	Header      http.Header
+	Body        []byte
+}
+
+// IdempotencyStore keeps the responses recorded by WithIdempotency.
+// Implementations must be safe for concurrent use, and may expire responses
+// once clients are no longer expected to retry.
+type IdempotencyStore interface {
+	// Reserve reserves key for the request with fingerprint, which is being
+	// handled, unless key is reserved already. It reports whether it
+	// reserved it, and if not, returns what is recorded for it: the response
+	// of the request which reserved it, or, while that request is still
+	// being handled, an IdempotentResponse with only its Fingerprint set.
+	// Checking and reserving must be atomic, so that only one of concurrent
+	// requests with the same key is handled.
+	Reserve(ctx context.Context, key, fingerprint string) (IdempotentResponse, bool, error)
+	// Put records the response for key, which Reserve reserved.
+	Put(ctx context.Context, key string, rsp IdempotentResponse) error
+	// Release releases key, which Reserve reserved, without recording a
+	// response, so that the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// NewInMemoryIdempotencyStore returns an IdempotencyStore which keeps
+// responses in memory, forever.
+func NewInMemoryIdempotencyStore() IdempotencyStore {
+	return &inMemoryIdempotencyStore{responses: make(map[string]IdempotentResponse)}
+}
+
+type inMemoryIdempotencyStore struct {
+	mu        sync.Mutex
+	responses map[string]IdempotentResponse
+}
+
+func (s *inMemoryIdempotencyStore) Reserve(_ context.Context, key, fingerprint string) (IdempotentResponse, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rsp, ok := s.responses[key]; ok {
+		return rsp, false, nil
+	}
+	s.responses[key] = IdempotentResponse{Fingerprint: fingerprint}
+	return IdempotentResponse{}, true, nil
+}
+
+func (s *inMemoryIdempotencyStore) Put(_ context.Context, key string, rsp IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[key] = rsp
+	return nil
+}
+
+func (s *inMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.responses, key)
+	return nil
+}
+
+// WithIdempotency makes POST requests with an Idempotency-Key header safe to
+// retry. The response to the first request with a key is recorded in store
+// and replayed to later requests with the same key, which don't reach the
+// handler. A later request with the same key but a different body is
+// rejected with 422. Responses with a 5xx status are not recorded, so that
+// the request can be retried, and neither are errors. Keys are scoped to the
+// Principal of the request, if any. While the first request with a key is
+// being handled, later ones are rejected with 409, or 422 if their body is
+// different.
+func WithIdempotency(store IdempotencyStore) RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.idempotency = store
+	}
+}
+
+// idempotency returns the middleware implementing WithIdempotency. It reads
+// the whole request body, so it runs after the body size limit.
+func idempotency(store IdempotencyStore) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			key := req.Header.Get(IdempotencyKeyHeader)
+			if req.Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+			if principal, ok := Principal(req.Context()); ok {
+				key = principal + "\x00" + key
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			req.Body.Close()
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			sum := sha256.Sum256(append([]byte(req.Method+" "+req.URL.Path+"\x00"), body...))
+			fingerprint := hex.EncodeToString(sum[:])
+
+			recorded, reserved, err := store.Reserve(req.Context(), key, fingerprint)
+			if err != nil {
+				return err
+			}
+			if !reserved {
+				switch {
+				case recorded.Fingerprint != fingerprint:
+					return c.JSON(http.StatusUnprocessableEntity, Error{
+						Code:    "idempotency_key_reused",
+						Message: IdempotencyKeyHeader + " was already used for a different request",
+					})
+				case recorded.StatusCode == 0:
+					return c.JSON(http.StatusConflict, Error{
+						Code:    "idempotency_key_in_use",
+						Message: "a request with the same " + IdempotencyKeyHeader + " is still being handled",
+					})
+				}
+				for name, values := range recorded.Header {
+					c.Response().Header()[name] = values
+				}
+				return c.Blob(recorded.StatusCode, recorded.Header.Get(echo.HeaderContentType), recorded.Body)
+			}
+
+			// The key is released unless a response is recorded, so that a
+			// request which failed, or panicked, can be retried.
+			put := false
+			defer func() {
+				if !put {
+					store.Release(context.Background(), key)
+				}
+			}()
+			before := c.Response().Header().Clone()
+			rec := &recordingWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = rec
+			if err := next(c); err != nil {
+				return err
+			}
+			status := c.Response().Status
+			if status >= 500 {
+				return nil
+			}
+			if err := store.Put(req.Context(), key, IdempotentResponse{
+				Fingerprint: fingerprint,
+				StatusCode:  status,
+				Header:      addedHeader(before, c.Response().Header()),
+				Body:        rec.body.Bytes(),
+			}); err != nil {
+				return err
+			}
+			put = true
+			return nil
+		}
+	}
+}
+
+// recordingWriter is an http.ResponseWriter which keeps a copy of the body
+// written through it.
+type recordingWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// addedHeader returns the fields of after which are not in before, i.e. the
+// ones set by the handler rather than by the middleware before it.
+func addedHeader(before, after http.Header) http.Header {
+	added := make(http.Header)
+	for name, values := range after {
+		if _, ok := before[name]; !ok {
+			added[name] = values
+		}
+	}
+	return added
+}
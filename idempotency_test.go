@@ -0,0 +1,119 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// blockingServer is a ServerInterface whose PostOrder waits for release to be
+// closed once it has been called, which it reports on started.
+type blockingServer struct {
+	ServerInterface
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingServer) PostOrder(c echo.Context, params PostOrderParams) error {
+	s.started <- struct{}{}
+	<-s.release
+	return s.ServerInterface.PostOrder(c, params)
+}
+
+func postOrder(e *echo.Echo, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/order", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(IdempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithIdempotency(NewInMemoryIdempotencyStore())); err != nil {
+		t.Fatal(err)
+	}
+	body := `{"item":"Tea Table Green","total":{"amount":1499,"currency":"EUR"}}`
+
+	first := postOrder(e, "key-1", body)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("got %d for the first request, want %d: %s", first.Code, http.StatusCreated, first.Body)
+	}
+	replay := postOrder(e, "key-1", body)
+	if replay.Code != http.StatusCreated || replay.Body.String() != first.Body.String() {
+		t.Errorf("got %d %s for the replay, want %d %s", replay.Code, replay.Body, first.Code, first.Body)
+	}
+	if got, want := replay.Header().Get("Location"), first.Header().Get("Location"); got != want {
+		t.Errorf("got Location %q for the replay, want %q", got, want)
+	}
+
+	other := postOrder(e, "key-1", `{"item":"Tea Table Red","total":{"amount":1499,"currency":"EUR"}}`)
+	if other.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("got %d for another body with the same key, want %d", other.Code, http.StatusUnprocessableEntity)
+	}
+	var rsp Error
+	if err := json.Unmarshal(other.Body.Bytes(), &rsp); err != nil || rsp.Code != "idempotency_key_reused" {
+		t.Errorf("got the Error %s, want the code idempotency_key_reused", other.Body)
+	}
+
+	if another := postOrder(e, "key-2", body); another.Code != http.StatusCreated || another.Body.String() == first.Body.String() {
+		t.Errorf("got %d %s for another key, want another order", another.Code, another.Body)
+	}
+}
+
+// TestIdempotencyConcurrent checks that of concurrent requests with the same
+// key only the first is handled, and the others rejected with 409.
+func TestIdempotencyConcurrent(t *testing.T) {
+	si := &blockingServer{ServerInterface: NewInMemoryStore(), started: make(chan struct{}, 1), release: make(chan struct{})}
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, si, WithIdempotency(NewInMemoryIdempotencyStore())); err != nil {
+		t.Fatal(err)
+	}
+	body := `{"item":"Tea Table Green","total":{"amount":1499,"currency":"EUR"}}`
+
+	var wg sync.WaitGroup
+	var first *httptest.ResponseRecorder
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		first = postOrder(e, "key-1", body)
+	}()
+	<-si.started
+
+	if rec := postOrder(e, "key-1", body); rec.Code != http.StatusConflict {
+		t.Errorf("got %d for a request while the first is handled, want %d", rec.Code, http.StatusConflict)
+	}
+	if rec := postOrder(e, "key-1", `{"item":"Tea Table Red","total":{"amount":1499,"currency":"EUR"}}`); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("got %d for another body while the first is handled, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+	close(si.release)
+	wg.Wait()
+
+	if first.Code != http.StatusCreated {
+		t.Fatalf("got %d for the first request, want %d", first.Code, http.StatusCreated)
+	}
+	if rec := postOrder(e, "key-1", body); rec.Body.String() != first.Body.String() {
+		t.Errorf("got %s for the replay, want %s", rec.Body, first.Body)
+	}
+}
+
+// TestIdempotencyError checks that the key of a request which failed can be
+// used again.
+func TestIdempotencyError(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithIdempotency(NewInMemoryIdempotencyStore())); err != nil {
+		t.Fatal(err)
+	}
+	if rec := postOrder(e, "key-1", `{"item":"Tea Table Green","price":1499,"total":{"amount":1,"currency":"EUR"}}`); rec.Code != http.StatusBadRequest {
+		t.Fatalf("got %d for a mismatched price, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if rec := postOrder(e, "key-1", `{"item":"Tea Table Green","price":1499}`); rec.Code != http.StatusCreated {
+		t.Errorf("got %d for a retry with the key of a failed request, want %d", rec.Code, http.StatusCreated)
+	}
+}
@@ -0,0 +1,30 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestMarshalOmitsAbsentFields checks that optional fields which are not set
+// are absent on the wire, rather than null, which strict parsers reject.
+func TestMarshalOmitsAbsentFields(t *testing.T) {
+	price := 1499
+	for _, tt := range []struct {
+		name string
+		body interface{}
+		want string
+	}{
+		// item is required, so it is always sent.
+		{"PutOrderIdJSONRequestBody", PutOrderIdJSONRequestBody{Price: &price}, `{"item":"","price":1499}`},
+		{"PatchOrderIdJSONRequestBody", PatchOrderIdJSONRequestBody{Price: &price}, `{"price":1499}`},
+		{"PostOrderJSONRequestBody", PostOrderJSONRequestBody{Price: &price}, `{"price":1499}`},
+	} {
+		b, err := json.Marshal(tt.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, b, tt.want)
+		}
+	}
+}
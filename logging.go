@@ -0,0 +1,100 @@
+package spec
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxLoggedBody is how much of a request body LogRequestBodies logs.
+const maxLoggedBody = 4 << 10
+
+// LoggingOption configures LoggingMiddleware.
+type LoggingOption func(*loggingConfig)
+
+type loggingConfig struct {
+	requestBodies bool
+}
+
+// LogRequestBodies makes LoggingMiddleware log the first 4KB of every request
+// body.
+func LogRequestBodies() LoggingOption {
+	return func(cfg *loggingConfig) {
+		cfg.requestBodies = true
+	}
+}
+
+// LoggingMiddleware returns a middleware which logs one line per request to
+// logger, with its method, path, operation, status and latency. Headers are
+// never logged, so neither is the API key.
+func LoggingMiddleware(logger *slog.Logger, opts ...LoggingOption) echo.MiddlewareFunc {
+	var cfg loggingConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			start := time.Now()
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("operation", routeOperationID(req.Method, c.Path())),
+			}
+			if cfg.requestBodies && req.Body != nil {
+				body, err := peekBody(req, maxLoggedBody)
+				if err != nil {
+					return err
+				}
+				attrs = append(attrs, slog.String("body", string(body)))
+			}
+
+			err := next(c)
+
+			status := responseStatus(c, err)
+			attrs = append(attrs,
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+			)
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.LogAttrs(req.Context(), level, "request", attrs...)
+			return err
+		}
+	}
+}
+
+// peekBody returns up to n bytes of the body of req, leaving the body intact
+// for the handler.
+func peekBody(req *http.Request, n int64) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, n))
+	if err != nil {
+		return nil, err
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+	return body, nil
+}
+
+// responseStatus returns the status of the response to c, given the error
+// the handler returned. Echo only writes the response for an error after the
+// middleware has returned, so the status is derived from the error then.
+func responseStatus(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	if httpErr, ok := err.(*echo.HTTPError); ok {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,44 @@
+package spec
+
+import (
+	"net/http"
+	"time"
+)
+
+// ResponseObserver is called for every completed request of a client with
+// the ID of its operation, e.g. PutOrderId, its response status, or 0 if the
+// request failed without a response, and how long it took to get the
+// response headers.
+type ResponseObserver func(op string, status int, dur time.Duration)
+
+// WithResponseObserver calls observe for every request of the client, so
+// that metrics can be broken down by operation and status without parsing
+// URLs. With WithRetry, it observes the outcome of the retries of a request,
+// rather than every attempt. Responses of WithResponseCache which make no
+// request are not observed.
+func WithResponseObserver(observe ResponseObserver) ClientOption {
+	return func(c *Client) error {
+		c.setLayer(observerLayer, func(doer HttpRequestDoer) HttpRequestDoer {
+			return &observingDoer{doer: doer, observe: observe, client: c}
+		})
+		return nil
+	}
+}
+
+type observingDoer struct {
+	doer    HttpRequestDoer
+	observe ResponseObserver
+	client  *Client
+}
+
+func (d *observingDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := d.doer.Do(req)
+
+	status := 0
+	if err == nil {
+		status = rsp.StatusCode
+	}
+	d.observe(clientOperationID(d.client.Server, req), status, time.Since(start))
+	return rsp, err
+}
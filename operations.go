@@ -0,0 +1,241 @@
+package spec
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The IDs of the operations of the spec, as used in the names of the
+// generated methods. Keep them in sync with spec.yaml.
+const (
+	OpGetHealthz        = "GetHealthz"
+	OpPostOrder         = "PostOrder"
+	OpDeleteOrderId     = "DeleteOrderId"
+	OpGetOrderId        = "GetOrderId"
+	OpPatchOrderId      = "PatchOrderId"
+	OpPutOrderId        = "PutOrderId"
+	OpListOrders        = "ListOrders"
+	OpExportOrders      = "ExportOrders"
+	OpBatchCreateOrders = "BatchCreateOrders"
+	OpGetReadyz         = "GetReadyz"
+	OpGetVersion        = "GetVersion"
+)
+
+// The paths of the spec, with parameters written as {id}.
+const (
+	PathHealthz      = "/healthz"
+	PathOrder        = "/order"
+	PathOrderId      = "/order/{id}"
+	PathOrders       = "/orders"
+	PathOrdersBatch  = "/orders:batch"
+	PathOrdersExport = "/orders/export"
+	PathReadyz       = "/readyz"
+	PathVersion      = "/version"
+)
+
+// OperationInfo describes an operation of the spec.
+type OperationInfo struct {
+	OperationId string
+	Method      string
+	Path        string
+}
+
+// Operations returns every operation of the spec, in the order of
+// ServerInterface.
+func Operations() []OperationInfo {
+	return []OperationInfo{
+		{OperationId: OpGetHealthz, Method: http.MethodGet, Path: PathHealthz},
+		{OperationId: OpPostOrder, Method: http.MethodPost, Path: PathOrder},
+		{OperationId: OpDeleteOrderId, Method: http.MethodDelete, Path: PathOrderId},
+		{OperationId: OpGetOrderId, Method: http.MethodGet, Path: PathOrderId},
+		{OperationId: OpPatchOrderId, Method: http.MethodPatch, Path: PathOrderId},
+		{OperationId: OpPutOrderId, Method: http.MethodPut, Path: PathOrderId},
+		{OperationId: OpListOrders, Method: http.MethodGet, Path: PathOrders},
+		{OperationId: OpExportOrders, Method: http.MethodGet, Path: PathOrdersExport},
+		{OperationId: OpBatchCreateOrders, Method: http.MethodPost, Path: PathOrdersBatch},
+		{OperationId: OpGetReadyz, Method: http.MethodGet, Path: PathReadyz},
+		{OperationId: OpGetVersion, Method: http.MethodGet, Path: PathVersion},
+	}
+}
+
+// operation is an operation of the spec, as found by method and path.
+type operation struct {
+	id     string
+	method string
+	// path matches the paths of requests for the operation, relative to the
+	// base URL of the API, and route the Echo route paths it is served
+	// under, prefix included.
+	path  *regexp.Regexp
+	route *regexp.Regexp
+	// public is true if the operation requires no authentication.
+	public bool
+	spec   *openapi3.Operation
+}
+
+var (
+	operationsOnce sync.Once
+	operations     []operation
+)
+
+// loadOperations returns the operations of the embedded spec, with the paths
+// without parameters first, so that they take precedence when matching.
+func loadOperations() []operation {
+	operationsOnce.Do(func() {
+		swagger, err := GetSwagger()
+		if err != nil {
+			return
+		}
+		paths := make([]string, 0, len(swagger.Paths))
+		for path := range swagger.Paths {
+			paths = append(paths, path)
+		}
+		sort.Slice(paths, func(i, j int) bool {
+			ci, cj := strings.Count(paths[i], "{"), strings.Count(paths[j], "{")
+			if ci != cj {
+				return ci < cj
+			}
+			return paths[i] < paths[j]
+		})
+
+		for _, path := range paths {
+			pattern, route := pathPattern(path), routePattern(path)
+			for method, op := range swagger.Paths[path].Operations() {
+				operations = append(operations, operation{
+					id:     strings.ToUpper(op.OperationID[:1]) + op.OperationID[1:],
+					method: method,
+					path:   pattern,
+					route:  route,
+					public: op.Security != nil && len(*op.Security) == 0,
+					spec:   op,
+				})
+			}
+		}
+	})
+	return operations
+}
+
+// pathPattern returns a regexp matching request paths which are the spec
+// path as a whole: parameters, written as {id} in the spec, match any path
+// segment, and anything else only itself.
+func pathPattern(path string) *regexp.Regexp {
+	return regexp.MustCompile("^" + segmentsPattern(path, "[^/]+") + "$")
+}
+
+// routePattern returns a regexp matching the Echo route paths the spec path
+// is registered as, e.g. /order/:id for /order/{id}, under any prefix, like
+// /api/v1 for a group or WithPathPrefix.
+func routePattern(path string) *regexp.Regexp {
+	return regexp.MustCompile("^(/.*)?" + segmentsPattern(path, ":[^/]+") + "$")
+}
+
+// segmentsPattern returns a regexp matching path, with the parameters of it
+// matching param.
+func segmentsPattern(path, param string) string {
+	var pattern strings.Builder
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		pattern.WriteString("/")
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			pattern.WriteString(param)
+		} else {
+			pattern.WriteString(regexp.QuoteMeta(segment))
+		}
+	}
+	return pattern.String()
+}
+
+// findOperation returns the operation a request with method and path,
+// relative to the base URL of the API, is for, or nil if it is not for an
+// operation of the spec.
+func findOperation(method, path string) *operation {
+	ops := loadOperations()
+	for i := range ops {
+		if ops[i].method == method && ops[i].path.MatchString(path) {
+			return &ops[i]
+		}
+	}
+	return nil
+}
+
+// findRoute returns the operation served at the Echo route path routePath
+// for method, as returned by c.Path(), or nil if it is not an operation of
+// the spec. Route paths tell the operation apart even where request paths
+// don't, e.g. /order/orders, which is GetOrderId of the order "orders"
+// unless the API is served under /order.
+func findRoute(method, routePath string) *operation {
+	ops := loadOperations()
+	for i := range ops {
+		if ops[i].method == method && ops[i].route.MatchString(routePath) {
+			return &ops[i]
+		}
+	}
+	return nil
+}
+
+// operationID returns the ID of the operation a request with method and path,
+// relative to the base URL of the API, is for, e.g. PutOrderId, or "" if it
+// is not for an operation of the spec.
+func operationID(method, path string) string {
+	if op := findOperation(method, path); op != nil {
+		return op.id
+	}
+	return ""
+}
+
+// routeOperationID returns the ID of the operation served at the Echo route
+// path routePath for method, e.g. PutOrderId, or "" if there is none.
+func routeOperationID(method, routePath string) string {
+	if op := findRoute(method, routePath); op != nil {
+		return op.id
+	}
+	return ""
+}
+
+// clientOperationID returns the ID of the operation req, a request of a
+// client of the API at server, is for, or "" if it is not for an operation of
+// the spec, e.g. because it is not under the path of server.
+func clientOperationID(server string, req *http.Request) string {
+	path := req.URL.Path
+	if base, err := url.Parse(server); err == nil {
+		if prefix := strings.TrimRight(base.Path, "/"); prefix != "" {
+			if !strings.HasPrefix(path, prefix+"/") {
+				return ""
+			}
+			path = path[len(prefix):]
+		}
+	}
+	return operationID(req.Method, path)
+}
+
+// isPublic reports whether the Echo route path routePath for method serves
+// an operation of the spec which requires no authentication, like the
+// probes.
+func isPublic(method, routePath string) bool {
+	if op := findRoute(method, routePath); op != nil {
+		return op.public
+	}
+	return false
+}
+
+// routePrefix returns the prefix an Echo route path for a request with method
+// has before the spec path, e.g. /api/v1 for /api/v1/order/:id, whether it
+// comes from a group or from RegisterHandlersWithBaseURL.
+func routePrefix(method, routePath string) string {
+	for _, op := range loadOperations() {
+		if op.method != method {
+			continue
+		}
+		if m := op.route.FindStringSubmatchIndex(routePath); m != nil {
+			if m[2] < 0 {
+				return ""
+			}
+			return routePath[:m[3]]
+		}
+	}
+	return ""
+}
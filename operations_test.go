@@ -0,0 +1,120 @@
+package spec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRouteOperationID(t *testing.T) {
+	for _, tt := range []struct {
+		method, route, want string
+	}{
+		{http.MethodGet, "/orders", OpListOrders},
+		{http.MethodGet, "/order/:id", OpGetOrderId},
+		{http.MethodGet, "/api/v1/order/:id", OpGetOrderId},
+		{http.MethodGet, "/api/v1/healthz", OpGetHealthz},
+		{http.MethodPost, "/orders:batch", OpBatchCreateOrders},
+		// Served under /order, ListOrders has the route path /order/orders.
+		{http.MethodGet, "/order/orders", OpListOrders},
+		{http.MethodGet, "/apihealthz", ""},
+		{http.MethodGet, "", ""},
+		{http.MethodPost, "/orders", ""},
+	} {
+		if got := routeOperationID(tt.method, tt.route); got != tt.want {
+			t.Errorf("%s %s: got %q, want %q", tt.method, tt.route, got, tt.want)
+		}
+	}
+}
+
+func TestClientOperationID(t *testing.T) {
+	for _, tt := range []struct {
+		server, method, url, want string
+	}{
+		{"https://host/", http.MethodGet, "https://host/orders", OpListOrders},
+		{"https://host/", http.MethodGet, "https://host/order/orders", OpGetOrderId},
+		{"https://host/", http.MethodGet, "https://host/order/healthz", OpGetOrderId},
+		{"https://host/", http.MethodGet, "https://host/order/version", OpGetOrderId},
+		{"https://host/", http.MethodGet, "https://host/orders/export", OpExportOrders},
+		{"https://host/", http.MethodGet, "https://host/api/v1/orders", ""},
+		{"https://host/api/v1/", http.MethodGet, "https://host/api/v1/orders", OpListOrders},
+		{"https://host/api/v1/", http.MethodPut, "https://host/api/v1/order/234578", OpPutOrderId},
+		{"https://host/api/v1/", http.MethodGet, "https://host/orders", ""},
+		{"https://host/api/v1/", http.MethodGet, "https://host/api/v1x/orders", ""},
+	} {
+		req := httptest.NewRequest(tt.method, tt.url, nil)
+		if got := clientOperationID(tt.server, req); got != tt.want {
+			t.Errorf("%s %s under %s: got %q, want %q", tt.method, tt.url, tt.server, got, tt.want)
+		}
+	}
+}
+
+// TestOperations checks that Operations, and so the Op and Path constants,
+// are exactly the operations of the spec.
+func TestOperations(t *testing.T) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[OperationInfo]bool)
+	for path, item := range swagger.Paths {
+		for method, op := range item.Operations() {
+			id := strings.ToUpper(op.OperationID[:1]) + op.OperationID[1:]
+			want[OperationInfo{OperationId: id, Method: method, Path: path}] = true
+		}
+	}
+
+	got := make(map[OperationInfo]bool)
+	for _, op := range Operations() {
+		if got[op] {
+			t.Errorf("%v is listed twice", op)
+		}
+		got[op] = true
+		if !want[op] {
+			t.Errorf("%v is not an operation of the spec", op)
+		}
+	}
+	for op := range want {
+		if !got[op] {
+			t.Errorf("%v is missing from Operations", op)
+		}
+	}
+
+	ids := []string{
+		OpGetHealthz, OpPostOrder, OpDeleteOrderId, OpGetOrderId, OpPatchOrderId,
+		OpPutOrderId, OpListOrders, OpExportOrders, OpBatchCreateOrders,
+		OpGetReadyz, OpGetVersion,
+	}
+	if len(ids) != len(want) {
+		t.Errorf("got %d Op constants, want %d", len(ids), len(want))
+	}
+	for _, id := range ids {
+		if operationByID(id) == nil {
+			t.Errorf("%s is not an operation of the spec", id)
+		}
+	}
+
+	paths := []string{
+		PathHealthz, PathOrder, PathOrderId, PathOrders, PathOrdersBatch,
+		PathOrdersExport, PathReadyz, PathVersion,
+	}
+	if len(paths) != len(swagger.Paths) {
+		t.Errorf("got %d Path constants, want %d", len(paths), len(swagger.Paths))
+	}
+	for _, path := range paths {
+		if swagger.Paths[path] == nil {
+			t.Errorf("%s is not a path of the spec", path)
+		}
+	}
+}
+
+func operationByID(id string) *operation {
+	ops := loadOperations()
+	for i := range ops {
+		if ops[i].id == id {
+			return &ops[i]
+		}
+	}
+	return nil
+}
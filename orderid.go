@@ -0,0 +1,22 @@
+package spec
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// OrderIdPattern is the pattern every order ID matches, as declared for the
+// id path parameter in spec.yaml. IDs which don't match are rejected by the
+// request validation with 400.
+const OrderIdPattern = `^[A-Za-z0-9_-]{1,64}$`
+
+var orderIdRegexp = regexp.MustCompile(OrderIdPattern)
+
+// ValidateOrderId returns an error if id doesn't match OrderIdPattern, so that
+// clients can check an ID before sending it.
+func ValidateOrderId(id string) error {
+	if !orderIdRegexp.MatchString(id) {
+		return fmt.Errorf("invalid order id %q: must match %s", id, OrderIdPattern)
+	}
+	return nil
+}
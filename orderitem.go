@@ -0,0 +1,64 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AllOrderItems returns every valid OrderItem, in the order of the spec. The
+// values of OrderItem are stored with orders, so they must not change when
+// spec.yaml does, e.g. when its enum is reordered; TestOrderItems locks them.
+func AllOrderItems() []OrderItem {
+	return []OrderItem{
+		OrderItemTeaTableGreen,
+		OrderItemTeaTableRed,
+	}
+}
+
+// IsValid reports whether i is one of the values allowed by the spec.
+func (i OrderItem) IsValid() bool {
+	for _, item := range AllOrderItems() {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseOrderItem returns the OrderItem exactly matching s, or an error if s
+// is not one of the values allowed by the spec.
+func ParseOrderItem(s string) (OrderItem, error) {
+	if i := OrderItem(s); i.IsValid() {
+		return i, nil
+	}
+	return "", fmt.Errorf("invalid order item %q", s)
+}
+
+// ParseOrderItemFold is like ParseOrderItem, but matches case-insensitively
+// and treats underscores and hyphens as spaces, so that "tea_table_green"
+// parses as OrderItemTeaTableGreen.
+func ParseOrderItemFold(s string) (OrderItem, error) {
+	normalized := strings.NewReplacer("_", " ", "-", " ").Replace(s)
+	for _, item := range AllOrderItems() {
+		if strings.EqualFold(normalized, string(item)) {
+			return item, nil
+		}
+	}
+	return "", fmt.Errorf("invalid order item %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values which are not
+// allowed by the spec.
+func (i *OrderItem) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	item, err := ParseOrderItem(s)
+	if err != nil {
+		return err
+	}
+	*i = item
+	return nil
+}
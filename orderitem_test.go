@@ -0,0 +1,60 @@
+package spec
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestOrderItems locks the constants of OrderItem, which are stored with
+// orders: a constant which changes value, or a value which is added or
+// removed in spec.yaml, fails it.
+func TestOrderItems(t *testing.T) {
+	want := []OrderItem{"Tea Table Green", "Tea Table Red"}
+	constants := map[string]OrderItem{
+		"OrderItemTeaTableGreen": OrderItemTeaTableGreen,
+		"OrderItemTeaTableRed":   OrderItemTeaTableRed,
+	}
+	for name, value := range map[string]OrderItem{
+		"OrderItemTeaTableGreen": "Tea Table Green",
+		"OrderItemTeaTableRed":   "Tea Table Red",
+	} {
+		if constants[name] != value {
+			t.Errorf("got %s = %q, want %q", name, constants[name], value)
+		}
+	}
+
+	if got := AllOrderItems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got AllOrderItems() = %q, want %q", got, want)
+	}
+	if got := specEnum[OrderItem](t, "OrderItem"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got the enum %q in the spec, want %q", got, want)
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	if got, want := AllCurrencies(), specEnum[Currency](t, "Currency"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got AllCurrencies() = %q, want the enum of the spec %q", got, want)
+	}
+}
+
+// specEnum returns the enum of the string schema name of the spec.
+func specEnum[T ~string](t *testing.T, name string) []T {
+	t.Helper()
+	swagger, err := GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := swagger.Components.Schemas[name]
+	if schema == nil {
+		t.Fatalf("no schema %s", name)
+	}
+	var enum []T
+	for _, value := range schema.Value.Enum {
+		s, ok := value.(string)
+		if !ok {
+			t.Fatalf("%s has the value %v, which is not a string", name, value)
+		}
+		enum = append(enum, T(s))
+	}
+	return enum
+}
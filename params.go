@@ -0,0 +1,71 @@
+package spec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+// paramDefaults returns a middleware which adds the query and header
+// parameters the request omits, but the spec gives a default for, e.g.
+// limit=20 for listOrders, to the request. The wrappers of ServerInterface
+// bind and coerce parameters into the typed Params of the operation and
+// reject malformed ones with 400 before the handler runs, so, with the
+// defaults in the request, handlers find them in their Params too.
+func paramDefaults() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			op := findRoute(c.Request().Method, c.Path())
+			if op == nil {
+				return next(c)
+			}
+
+			req := c.Request()
+			query := c.QueryParams()
+			var changed bool
+			for _, ref := range op.spec.Parameters {
+				param := ref.Value
+				if param == nil || param.Schema == nil || param.Schema.Value.Default == nil {
+					continue
+				}
+				value := paramValue(param.Schema.Value.Default)
+				switch param.In {
+				case openapi3.ParameterInQuery:
+					if _, ok := query[param.Name]; !ok {
+						query.Set(param.Name, value)
+						changed = true
+					}
+				case openapi3.ParameterInHeader:
+					if req.Header.Get(param.Name) == "" {
+						req.Header.Set(param.Name, value)
+					}
+				}
+			}
+			if changed {
+				// c.QueryParams caches the query, so both are updated.
+				req.URL.RawQuery = query.Encode()
+			}
+			return next(c)
+		}
+	}
+}
+
+// paramValue returns value, a default of the spec as decoded from JSON, as
+// it is written in a query or header with the simple or form style and
+// explode false, e.g. 20 or id,price.
+func paramValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = paramValue(item)
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,67 @@
+package spec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// listParamsServer records the Params its ListOrders is called with.
+type listParamsServer struct {
+	ServerInterface
+	params *ListOrdersParams
+}
+
+func (s listParamsServer) ListOrders(c echo.Context, params ListOrdersParams) error {
+	*s.params = params
+	return c.NoContent(http.StatusOK)
+}
+
+// TestParamDefaults checks that the handlers find the defaults of the spec
+// in the Params of the parameters a request omits, and that malformed
+// parameters are rejected with 400 before the handler runs.
+func TestParamDefaults(t *testing.T) {
+	for _, validate := range []bool{false, true} {
+		var opts []RegisterOption
+		if validate {
+			opts = append(opts, WithValidation())
+		}
+		var params ListOrdersParams
+		e := echo.New()
+		if err := RegisterHandlersWithOptions(e, listParamsServer{NewInMemoryStore(), &params}, opts...); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			query      string
+			wantStatus int
+			wantLimit  int
+		}{
+			{"", http.StatusOK, 20},
+			{"?cursor=MjM0NTc4", http.StatusOK, 20},
+			{"?limit=5", http.StatusOK, 5},
+			{"?limit=abc", http.StatusBadRequest, 0},
+			{"?limit=1.5", http.StatusBadRequest, 0},
+			{"?limit=", http.StatusBadRequest, 0},
+		} {
+			params = ListOrdersParams{}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/orders"+tt.query, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("validate %t, %q: got status %d, want %d: %s", validate, tt.query, rec.Code, tt.wantStatus, rec.Body)
+				continue
+			}
+			if tt.wantStatus != http.StatusOK {
+				if params.Limit != nil {
+					t.Errorf("validate %t, %q: the handler ran with the limit %d", validate, tt.query, *params.Limit)
+				}
+				continue
+			}
+			if params.Limit == nil || *params.Limit != tt.wantLimit {
+				t.Errorf("validate %t, %q: got the limit %v, want %d", validate, tt.query, params.Limit, tt.wantLimit)
+			}
+		}
+	}
+}
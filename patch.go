@@ -0,0 +1,46 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON implements json.Unmarshaler. A field which is absent decodes
+// to nil, so that it is left unchanged by Apply. Fields of an order can't be
+// removed, so a field explicitly set to null is rejected rather than being
+// mistaken for an absent one.
+func (b *PatchOrderIdJSONRequestBody) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, value := range fields {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			return fmt.Errorf("field %q must not be null", name)
+		}
+	}
+
+	// plain has the fields of PatchOrderIdJSONRequestBody, but not this method.
+	type plain PatchOrderIdJSONRequestBody
+	return json.Unmarshal(data, (*plain)(b))
+}
+
+// Apply returns order with the fields set in b replaced. Price and total
+// replace each other, as they are related: setting either sets both, or
+// clears the price for a total in a currency other than PriceCurrency. If b
+// sets both but they disagree, the total wins, so callers should reject such
+// patches first.
+func (b PatchOrderIdJSONRequestBody) Apply(order Order) Order {
+	if b.Item != nil {
+		order.Item = b.Item
+	}
+	if b.Price != nil || b.Total != nil {
+		price, total, invalid := reconcilePrice(b.Price, b.Total)
+		if invalid != nil {
+			price, total, _ = reconcilePrice(nil, b.Total)
+		}
+		order.Price, order.Total = price, total
+	}
+	return order
+}
@@ -0,0 +1,61 @@
+package spec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestPatchOrderIdNull checks that a PATCH setting a field of the order to
+// null is rejected with 400, with and without WithValidation, rather than
+// taken for a PATCH leaving it unchanged.
+func TestPatchOrderIdNull(t *testing.T) {
+	for _, validate := range []bool{false, true} {
+		var opts []RegisterOption
+		if validate {
+			opts = append(opts, WithValidation())
+		}
+		e := echo.New()
+		if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), opts...); err != nil {
+			t.Fatal(err)
+		}
+		serve := func(method, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, "/order/234578", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec
+		}
+		const order = `{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}`
+		if rec := serve(http.MethodPut, `{"item":"Tea Table Green","price":1499}`); rec.Code != http.StatusCreated {
+			t.Fatalf("got status %d creating the order, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+		}
+
+		for _, body := range []string{
+			`{"item":null}`,
+			`{"price":null}`,
+			`{"total":null}`,
+			`{"item":"Tea Table Red","price":null}`,
+		} {
+			rec := serve(http.MethodPatch, body)
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("validate %t: got status %d for %s, want %d: %s", validate, rec.Code, body, http.StatusBadRequest, rec.Body)
+			}
+			if !validate && !strings.Contains(rec.Body.String(), "must not be null") {
+				t.Errorf("got the Error %s for %s, want one saying the field must not be null", rec.Body, body)
+			}
+		}
+		if rec := serve(http.MethodGet, ""); strings.TrimSpace(rec.Body.String()) != order {
+			t.Errorf("validate %t: got the order %s after the PATCHes, want it unchanged", validate, rec.Body)
+		}
+
+		// An absent item is left unchanged.
+		rec := serve(http.MethodPatch, `{"price":1299}`)
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"item":"Tea Table Green","price":1299`) {
+			t.Errorf("validate %t: got status %d and %s for a PATCH of the price, want the item unchanged", validate, rec.Code, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package spec
+
+import "strings"
+
+// PreferenceAppliedHeader is the response header which reports the
+// preferences of Prefer which were applied.
+const PreferenceAppliedHeader = "Preference-Applied"
+
+// The return preferences of Prefer.
+const (
+	PreferReturnMinimal        Prefer = "return=minimal"
+	PreferReturnRepresentation Prefer = "return=representation"
+)
+
+// Return returns the return preference of p, PreferReturnMinimal or
+// PreferReturnRepresentation, or "" if p has neither. Other preferences p
+// lists are ignored.
+func (p Prefer) Return() Prefer {
+	for _, preference := range strings.Split(string(p), ",") {
+		// Parameters of the preference, after ";", don't matter.
+		preference, _, _ = strings.Cut(preference, ";")
+		name, value, _ := strings.Cut(preference, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "return") {
+			continue
+		}
+		switch value = strings.Trim(strings.TrimSpace(value), `"`); strings.ToLower(value) {
+		case "minimal":
+			return PreferReturnMinimal
+		case "representation":
+			return PreferReturnRepresentation
+		}
+	}
+	return ""
+}
+
+// preferredReturn returns the return preference of prefer, a Prefer
+// parameter, or "" if there is none.
+func preferredReturn(prefer *Prefer) Prefer {
+	if prefer == nil {
+		return ""
+	}
+	return prefer.Return()
+}
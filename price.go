@@ -0,0 +1,81 @@
+package spec
+
+import "strings"
+
+// PriceCurrency is the currency of the deprecated price of orders, which is
+// the amount of their total in it.
+const PriceCurrency = CurrencyEUR
+
+// reconcilePrice returns the price and total of an order sent with price and
+// total, either of which may be nil, as the spec relates them: a price alone
+// stands for a total in PriceCurrency, and a total in PriceCurrency has its
+// amount as price too. It returns an Error if either is invalid, or both are
+// set but disagree.
+func reconcilePrice(price *int, total *Money) (*int, *Money, *Error) {
+	if invalid := checkPrice(price, total); invalid != nil {
+		return nil, nil, invalid
+	}
+	switch {
+	case price == nil && total == nil:
+		return nil, nil, nil
+	case total == nil:
+		return price, &Money{Amount: int64(*price), Currency: PriceCurrency}, nil
+	case price == nil:
+		if total.Currency != PriceCurrency {
+			return nil, total, nil
+		}
+		amount := int(total.Amount)
+		return &amount, total, nil
+	}
+
+	if total.Currency != PriceCurrency || total.Amount != int64(*price) {
+		details := []string{"price: must be the amount of total, in " + string(PriceCurrency)}
+		return nil, nil, &Error{
+			Code:    "price_mismatch",
+			Message: "the price and total of the order disagree",
+			Details: &details,
+		}
+	}
+	return price, total, nil
+}
+
+// checkPrice returns an Error listing what is wrong with price and total,
+// either of which may be nil, or nil if nothing is. It checks what the spec
+// requires of them, which request validation only does if it is on, so that
+// no write stores an invalid price or total.
+func checkPrice(price *int, total *Money) *Error {
+	var details []string
+	if price != nil && *price < 1 {
+		details = append(details, "price: must be at least 1")
+	}
+	if total != nil {
+		if total.Amount < 1 {
+			details = append(details, "total.amount: must be at least 1")
+		}
+		if !total.Currency.IsValid() {
+			currencies := make([]string, len(AllCurrencies()))
+			for i, currency := range AllCurrencies() {
+				currencies[i] = string(currency)
+			}
+			details = append(details, "total.currency: must be one of "+strings.Join(currencies, ", "))
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return &Error{
+		Code:    "invalid_request",
+		Message: "the price or total of the order is invalid",
+		Details: &details,
+	}
+}
+
+// newOrder returns an order with item, price and total, its price and total
+// reconciled, or an Error if they are invalid or disagree.
+func newOrder(item *OrderItem, price *int, total *Money) (Order, *Error) {
+	price, total, invalid := reconcilePrice(price, total)
+	if invalid != nil {
+		return Order{}, invalid
+	}
+	return Order{Item: item, Price: price, Total: total}, nil
+}
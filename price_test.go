@@ -0,0 +1,72 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestInvalidPrice checks that no write stores an invalid price or total,
+// even without WithValidation.
+func TestInvalidPrice(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore()); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := serve(http.MethodPut, "/order/234578", `{"item":"Tea Table Green","price":1499}`); rec.Code != http.StatusCreated {
+		t.Fatalf("got status %d for a valid order, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+
+	for _, tt := range []struct {
+		name, method, target, body string
+		want                       []string
+	}{
+		{"negative price", http.MethodPut, "/order/234578", `{"item":"Tea Table Green","price":-5}`,
+			[]string{"price: must be at least 1"}},
+		{"negative amount and no currency", http.MethodPut, "/order/234578", `{"item":"Tea Table Green","total":{"amount":-3}}`,
+			[]string{"total.amount: must be at least 1", "total.currency: must be one of CHF, EUR, GBP, JPY, USD"}},
+		{"zero amount", http.MethodPost, "/order", `{"item":"Tea Table Green","total":{"amount":0,"currency":"EUR"}}`,
+			[]string{"total.amount: must be at least 1"}},
+		{"no currency", http.MethodPatch, "/order/234578", `{"total":{"amount":1499}}`,
+			[]string{"total.currency: must be one of CHF, EUR, GBP, JPY, USD"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(tt.method, tt.target, tt.body)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Details == nil || strings.Join(*rsp.Details, "; ") != strings.Join(tt.want, "; ") {
+				t.Errorf("got the Error %s, want the details %q", rec.Body, tt.want)
+			}
+		})
+	}
+
+	rec := serve(http.MethodPost, "/orders:batch", `{"orders":[{"item":"Tea Table Green","price":-5}]}`)
+	var batch BatchCreateOrdersOutput
+	if err := json.Unmarshal(rec.Body.Bytes(), &batch); err != nil {
+		t.Fatal(err)
+	}
+	if len(batch.Results) != 1 || batch.Results[0].Error == nil {
+		t.Errorf("got %s for a batch with a negative price, want an error", rec.Body)
+	}
+
+	rec = serve(http.MethodGet, "/order/234578", "")
+	if body := strings.TrimSpace(rec.Body.String()); !strings.Contains(body, `"price":1499`) {
+		t.Errorf("got the order %s after the invalid writes, want it unchanged", body)
+	}
+}
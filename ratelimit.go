@@ -0,0 +1,148 @@
+package spec
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// KeyedLimiter throttles requests by key. Implementations must be safe for
+// concurrent use.
+type KeyedLimiter interface {
+	// Allow reports whether a request with key may proceed now, and if not,
+	// how long to wait before it may.
+	Allow(key string) (ok bool, wait time.Duration)
+}
+
+// NewInMemoryLimiter returns a KeyedLimiter which allows limit requests per
+// period for each key, in bursts of up to limit, and keeps its state in
+// memory. It forgets keys which have been idle for a whole period.
+func NewInMemoryLimiter(limit int, per time.Duration) KeyedLimiter {
+	if limit < 1 || per <= 0 {
+		panic("spec: NewInMemoryLimiter needs a positive limit and period")
+	}
+	return &inMemoryLimiter{
+		limit:   float64(limit),
+		per:     per,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+type inMemoryLimiter struct {
+	limit float64
+	per   time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+// bucket holds the requests a key may still make, as of last.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (l *inMemoryLimiter) Allow(key string) (bool, time.Duration) {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.limit}
+		l.buckets[key] = b
+	} else {
+		b.tokens = math.Min(l.limit, b.tokens+l.limit*float64(now.Sub(b.last))/float64(l.per))
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) * float64(l.per) / l.limit)
+}
+
+// sweep forgets the keys idle for a period, whose buckets are full again, at
+// most once a period.
+func (l *inMemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.per {
+		return
+	}
+	l.swept = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= l.per {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimitMiddleware returns a middleware which throttles requests with
+// limiter, by the principal they are authenticated as, or by client IP for
+// requests without a valid API key. The principal is the one of Principal, if
+// RequireAPIKey ran before, or else the one validator returns for the
+// X-API-Key header, so that made-up keys can't each get a limit of their own;
+// validator may be nil if RequireAPIKey always runs before. Requests over the
+// limit are rejected with 429, a Retry-After header of the seconds to wait
+// and an Error. Install it with WithMiddleware.
+//
+// The client IP is the address of the peer, unless the Echo has an
+// IPExtractor: X-Forwarded-For and X-Real-IP are whatever the client sends,
+// unless a proxy in front replaces them, so they are only trusted when an
+// IPExtractor like echo.ExtractIPFromXFFHeader says so.
+func RateLimitMiddleware(limiter KeyedLimiter, validator APIKeyValidator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := "ip:" + clientIP(c)
+			if principal, ok := rateLimitPrincipal(c, validator); ok {
+				key = "principal:" + principal
+			}
+			ok, wait := limiter.Allow(key)
+			if ok {
+				return next(c)
+			}
+
+			seconds := int(math.Ceil(wait.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+			return c.JSON(http.StatusTooManyRequests, Error{
+				Code:    errorCode(http.StatusTooManyRequests),
+				Message: "too many requests, retry in " + strconv.Itoa(seconds) + "s",
+			})
+		}
+	}
+}
+
+// rateLimitPrincipal returns the principal RateLimitMiddleware throttles the
+// request of c by, and whether it is authenticated as one.
+func rateLimitPrincipal(c echo.Context, validator APIKeyValidator) (string, bool) {
+	if principal, ok := Principal(c.Request().Context()); ok {
+		return principal, true
+	}
+	values := c.Request().Header.Values(APIKeyHeader)
+	if validator == nil || len(values) != 1 {
+		return "", false
+	}
+	key := strings.TrimSpace(values[0])
+	if key == "" {
+		return "", false
+	}
+	return validator(key)
+}
+
+// clientIP returns the IP RateLimitMiddleware throttles requests without a
+// valid API key by.
+func clientIP(c echo.Context) string {
+	if e := c.Echo(); e != nil && e.IPExtractor != nil {
+		return c.RealIP()
+	}
+	return echo.ExtractIPDirect()(c.Request())
+}
@@ -0,0 +1,144 @@
+package spec
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	validator := func(key string) (string, bool) {
+		return map[string]string{"alice-key": "alice", "alice-other-key": "alice"}[key], key == "alice-key" || key == "alice-other-key"
+	}
+	for _, tt := range []struct {
+		name        string
+		middlewares []echo.MiddlewareFunc
+	}{
+		{"before RequireAPIKey", []echo.MiddlewareFunc{
+			RateLimitMiddleware(NewInMemoryLimiter(2, time.Hour), validator),
+			RequireAPIKey(validator),
+		}},
+		{"after RequireAPIKey", []echo.MiddlewareFunc{
+			RequireAPIKey(validator),
+			RateLimitMiddleware(NewInMemoryLimiter(2, time.Hour), nil),
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithMiddleware(tt.middlewares...)); err != nil {
+				t.Fatal(err)
+			}
+			get := func(remoteAddr, apiKey string) int {
+				req := httptest.NewRequest(http.MethodGet, "/order/234578", nil)
+				req.RemoteAddr = remoteAddr
+				if apiKey != "" {
+					req.Header.Set(APIKeyHeader, apiKey)
+				}
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				return rec.Code
+			}
+
+			// Every valid key of a principal shares its limit, wherever its
+			// requests come from.
+			for i, test := range []struct {
+				remoteAddr, apiKey string
+				want               int
+			}{
+				{"192.0.2.1:1234", "alice-key", http.StatusNotFound},
+				{"192.0.2.2:1234", "alice-other-key", http.StatusNotFound},
+				{"192.0.2.1:1234", "alice-key", http.StatusTooManyRequests},
+			} {
+				if got := get(test.remoteAddr, test.apiKey); got != test.want {
+					t.Errorf("got %d for request %d of alice, want %d", got, i+1, test.want)
+				}
+			}
+
+			if tt.name == "after RequireAPIKey" {
+				// RequireAPIKey rejects the other requests before they are
+				// throttled.
+				return
+			}
+
+			// Requests without a valid key are limited by IP, however many
+			// keys they make up, and don't use up the limit of a principal
+			// on their IP.
+			for i, test := range []struct {
+				remoteAddr, apiKey string
+				want               int
+			}{
+				{"192.0.2.1:1234", "", http.StatusUnauthorized},
+				{"192.0.2.1:1234", "made-up-key", http.StatusUnauthorized},
+				{"192.0.2.1:1234", "other-made-up-key", http.StatusTooManyRequests},
+				{"192.0.2.3:1234", "other-made-up-key", http.StatusUnauthorized},
+			} {
+				if got := get(test.remoteAddr, test.apiKey); got != test.want {
+					t.Errorf("got %d for request %d without a valid key, want %d", got, i+1, test.want)
+				}
+			}
+		})
+	}
+}
+
+// TestRateLimitForwardedFor checks that requests without a valid key can't
+// get a limit of their own by sending another X-Forwarded-For each time.
+func TestRateLimitForwardedFor(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithMiddleware(RateLimitMiddleware(NewInMemoryLimiter(1, time.Hour), nil))); err != nil {
+		t.Fatal(err)
+	}
+	for i, forwardedFor := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		req := httptest.NewRequest(http.MethodGet, "/order/234578", nil)
+		req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+		req.Header.Set(echo.HeaderXRealIP, forwardedFor)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if want := http.StatusTooManyRequests; i > 0 && rec.Code != want {
+			t.Errorf("got %d for request %d from %s, want %d", rec.Code, i+1, forwardedFor, want)
+		}
+	}
+
+	// With an IPExtractor trusting the proxy, they are clients of their own.
+	e.IPExtractor = echo.ExtractIPFromXFFHeader(echo.TrustLoopback(true), echo.TrustPrivateNet(true))
+	req := httptest.NewRequest(http.MethodGet, "/order/234578", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.4")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code == http.StatusTooManyRequests {
+		t.Errorf("got %d for a new client behind a trusted proxy", rec.Code)
+	}
+}
+
+func TestRateLimitRetryAfter(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), WithMiddleware(RateLimitMiddleware(NewInMemoryLimiter(1, time.Minute), nil))); err != nil {
+		t.Fatal(err)
+	}
+	var rec *httptest.ResponseRecorder
+	for i := 0; i < 2; i++ {
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/order/234578", nil))
+	}
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("got %d for the second request, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "60" {
+		t.Errorf("got Retry-After %q, want %q", got, "60")
+	}
+}
+
+func TestInMemoryLimiterForgetsIdleKeys(t *testing.T) {
+	limiter := NewInMemoryLimiter(1, 10*time.Millisecond).(*inMemoryLimiter)
+	for _, key := range []string{"ip:192.0.2.1", "ip:192.0.2.2", "ip:192.0.2.3"} {
+		limiter.Allow(key)
+	}
+	time.Sleep(20 * time.Millisecond)
+	limiter.Allow("ip:192.0.2.4")
+	if got := len(limiter.buckets); got != 1 {
+		t.Errorf("got %d buckets after the others were idle for a period, want 1", got)
+	}
+}
@@ -0,0 +1,185 @@
+package spec
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RegisterOption configures RegisterHandlersWithOptions.
+type RegisterOption func(*registerConfig)
+
+type registerConfig struct {
+	middleware   []echo.MiddlewareFunc
+	validate     bool
+	prefix       string
+	maxBodyBytes int64
+	idempotency  IdempotencyStore
+	cors         echo.MiddlewareFunc
+
+	disallowUnknownFields bool
+	errorHandler          echo.HTTPErrorHandler
+}
+
+// WithPathPrefix serves every route of the spec under prefix, e.g. /api/v1,
+// for deployments behind a gateway which mounts the API at a subpath. Leading
+// and trailing slashes of prefix don't matter. Registering the handlers on an
+// echo.Group has the same effect.
+func WithPathPrefix(prefix string) RegisterOption {
+	return func(cfg *registerConfig) {
+		if prefix = strings.Trim(prefix, "/"); prefix != "" {
+			prefix = "/" + prefix
+		}
+		cfg.prefix = prefix
+	}
+}
+
+// WithMiddleware adds middleware to every route of the spec. Middleware runs
+// in the order it is given, after CORS and before the checks of Content-Type,
+// the body size and unknown fields, parameter defaults, request validation and
+// idempotency.
+func WithMiddleware(m ...echo.MiddlewareFunc) RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.middleware = append(cfg.middleware, m...)
+	}
+}
+
+// WithValidation validates every request against the embedded OpenAPI spec
+// before it reaches the handler.
+func WithValidation() RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.validate = true
+	}
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, like
+// RegisterHandlers, and installs the middleware configured by opts on them.
+// Request bodies with a content type the spec doesn't declare for their
+// operation are rejected with 415, and the query and header parameters a
+// request omits are set to their defaults in the spec, if any.
+// Errors of the routes are responded to with NewHTTPErrorHandler, unless
+// WithErrorHandler says otherwise.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, opts ...RegisterOption) error {
+	cfg := registerConfig{maxBodyBytes: DefaultMaxBodyBytes, errorHandler: NewHTTPErrorHandler()}
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	var middleware []echo.MiddlewareFunc
+	if cfg.cors != nil {
+		middleware = append(middleware, cfg.cors)
+		for _, path := range specPaths() {
+			router.OPTIONS(cfg.prefix+path, preflightHandler, cfg.cors)
+		}
+	}
+	middleware = append(middleware, cfg.middleware...)
+	middleware = append(middleware, requireContentType())
+	if cfg.maxBodyBytes > 0 {
+		middleware = append(middleware, maxBodyBytes(cfg.maxBodyBytes))
+	}
+	if cfg.disallowUnknownFields {
+		middleware = append(middleware, disallowUnknownFields())
+	}
+	middleware = append(middleware, paramDefaults())
+	if cfg.validate {
+		validator, err := newRequestValidator()
+		if err != nil {
+			return err
+		}
+		middleware = append(middleware, validator)
+	}
+	if cfg.idempotency != nil {
+		middleware = append(middleware, idempotency(cfg.idempotency))
+	}
+
+	mr := middlewareRouter{router: router, middleware: middleware}
+	if cfg.errorHandler != nil {
+		mr.errors = handleErrors(cfg.errorHandler)
+	}
+	RegisterHandlersWithBaseURL(mr, si, cfg.prefix)
+	return nil
+}
+
+// RegisterHandlersWithValidation adds each server route to the EchoRouter,
+// like RegisterHandlers, but validates every request against the embedded
+// OpenAPI spec before it reaches si.
+func RegisterHandlersWithValidation(router EchoRouter, si ServerInterface) error {
+	return RegisterHandlersWithOptions(router, si, WithValidation())
+}
+
+// middlewareRouter is an EchoRouter which prepends its middleware to the
+// middleware of every route added through it, so that only the routes of the
+// spec are affected. errors, if set, comes first, to handle the errors of all
+// the others.
+type middlewareRouter struct {
+	router     EchoRouter
+	errors     echo.MiddlewareFunc
+	middleware []echo.MiddlewareFunc
+}
+
+func (r middlewareRouter) with(path string, m []echo.MiddlewareFunc) []echo.MiddlewareFunc {
+	var middleware []echo.MiddlewareFunc
+	if r.errors != nil {
+		middleware = append(middleware, r.errors)
+	}
+	if guard := customMethodGuard(path); guard != nil {
+		middleware = append(middleware, guard)
+	}
+	middleware = append(middleware, r.middleware...)
+	return append(middleware, m...)
+}
+
+// customMethodGuard returns a middleware for routes which end in a custom
+// method, like /orders:batch, which rejects requests for other paths with
+// 404, or nil for other routes. Echo takes the colon of a custom method for
+// the start of a path parameter, so the route also matches e.g. /ordersfoo.
+func customMethodGuard(path string) echo.MiddlewareFunc {
+	segment := path[strings.LastIndex(path, "/")+1:]
+	if strings.Index(segment, ":") <= 0 {
+		return nil
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !strings.HasSuffix(c.Request().URL.Path, "/"+segment) {
+				return echo.ErrNotFound
+			}
+			return next(c)
+		}
+	}
+}
+
+func (r middlewareRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.CONNECT(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.DELETE(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.GET(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.HEAD(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.OPTIONS(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.PATCH(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.POST(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.PUT(path, h, r.with(path, m)...)
+}
+
+func (r middlewareRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.TRACE(path, h, r.with(path, m)...)
+}
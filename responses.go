@@ -0,0 +1,57 @@
+package spec
+
+import (
+	"net/http"
+	"time"
+)
+
+// ClientWithResponses implements ClientWithResponsesInterface, so code which
+// depends on the interface can be given a fake in tests instead. Client, whose
+// raw methods ClientWithResponses wraps, implements ClientInterface likewise.
+var (
+	_ ClientWithResponsesInterface = (*ClientWithResponses)(nil)
+	_ ClientInterface              = (*Client)(nil)
+)
+
+// Location returns the Location header of the response, which points to the
+// order created by PostOrder.
+func (r PostOrderResponse) Location() string {
+	return responseHeader(r.HTTPResponse, "Location")
+}
+
+// ETag returns the ETag header of the response, which identifies the version
+// of the order.
+func (r GetOrderIdResponse) ETag() string {
+	return responseHeader(r.HTTPResponse, "ETag")
+}
+
+// LastModified returns the Last-Modified header of the response, or the zero
+// time if it has none. Pass it as the If-Modified-Since parameter of
+// GetOrderId to get the order only if it changed since.
+func (r GetOrderIdResponse) LastModified() time.Time {
+	t, err := http.ParseTime(responseHeader(r.HTTPResponse, "Last-Modified"))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// ETag returns the ETag header of the response, which identifies the version
+// of the order written by PutOrderId. Pass it as the If-Match parameter of
+// the next PutOrderId to only replace the order if it is unchanged.
+func (r PutOrderIdResponse) ETag() string {
+	return responseHeader(r.HTTPResponse, "ETag")
+}
+
+// ETag returns the ETag header of the response, which identifies the version
+// of the order updated by PatchOrderId.
+func (r PatchOrderIdResponse) ETag() string {
+	return responseHeader(r.HTTPResponse, "ETag")
+}
+
+func responseHeader(rsp *http.Response, key string) string {
+	if rsp != nil {
+		return rsp.Header.Get(key)
+	}
+	return ""
+}
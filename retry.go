@@ -0,0 +1,159 @@
+package spec
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// BackoffFunc returns how long to wait before the given retry, counting from
+// 1.
+type BackoffFunc func(retry int) time.Duration
+
+// ExponentialBackoff returns a BackoffFunc which waits base before the first
+// retry and doubles the wait for every further retry, up to max.
+func ExponentialBackoff(base, max time.Duration) BackoffFunc {
+	return func(retry int) time.Duration {
+		wait := base
+		for i := 1; i < retry && wait < max; i++ {
+			wait *= 2
+		}
+		if wait > max {
+			wait = max
+		}
+		return wait
+	}
+}
+
+// WithRetry retries idempotent requests, i.e. GET, HEAD, OPTIONS, PUT and
+// DELETE, and requests with an Idempotency-Key header, up to max times when
+// they fail with a network error, a 5xx status or 429. Between retries it
+// waits as long as the Retry-After header of the response says, up to
+// DefaultMaxRetryAfter unless WithMaxRetryAfter says otherwise, or as backoff
+// says otherwise. It gives up early rather than wait past the deadline of the
+// request context.
+func WithRetry(max int, backoff BackoffFunc, opts ...RetryOption) ClientOption {
+	return func(c *Client) error {
+		c.setLayer(retryLayer, func(doer HttpRequestDoer) HttpRequestDoer {
+			d := &retryDoer{doer: doer, max: max, backoff: backoff, maxRetryAfter: DefaultMaxRetryAfter}
+			for _, o := range opts {
+				o(d)
+			}
+			return d
+		})
+		return nil
+	}
+}
+
+// DefaultMaxRetryAfter is the longest WithRetry waits for a Retry-After
+// header unless WithMaxRetryAfter says otherwise, so that a server can't
+// hold up a request without a deadline for as long as it likes.
+const DefaultMaxRetryAfter = 30 * time.Second
+
+// RetryOption configures WithRetry.
+type RetryOption func(*retryDoer)
+
+// WithMaxRetryAfter makes WithRetry wait at most max for a Retry-After
+// header, instead of DefaultMaxRetryAfter.
+func WithMaxRetryAfter(max time.Duration) RetryOption {
+	return func(d *retryDoer) {
+		d.maxRetryAfter = max
+	}
+}
+
+type retryDoer struct {
+	doer          HttpRequestDoer
+	max           int
+	backoff       BackoffFunc
+	maxRetryAfter time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	rsp, err := d.doer.Do(req)
+	if !idempotent(req) {
+		return rsp, err
+	}
+
+	ctx := req.Context()
+	for retry := 1; retry <= d.max && retryable(rsp, err); retry++ {
+		if ctx.Err() != nil {
+			break
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// The body has been consumed and can't be sent again.
+			break
+		}
+
+		wait := d.backoff(retry)
+		if after, ok := retryAfter(rsp); ok {
+			wait = after
+			if wait > d.maxRetryAfter {
+				wait = d.maxRetryAfter
+			}
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			break
+		}
+
+		retryReq := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				break
+			}
+			retryReq.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return rsp, err
+		case <-timer.C:
+		}
+
+		if rsp != nil {
+			// Let the connection be reused.
+			_, _ = io.Copy(io.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		rsp, err = d.doer.Do(retryReq)
+	}
+	return rsp, err
+}
+
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+func retryable(rsp *http.Response, err error) bool {
+	return err != nil || rsp.StatusCode >= http.StatusInternalServerError ||
+		rsp.StatusCode == http.StatusTooManyRequests
+}
+
+// retryAfter returns the wait the Retry-After header of rsp asks for, given
+// either in seconds or as a date.
+func retryAfter(rsp *http.Response) (time.Duration, bool) {
+	if rsp == nil {
+		return 0, false
+	}
+	header := rsp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,78 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// unavailableServer returns a server which answers every request with 503
+// and the given Retry-After header, if any, and counts the attempts.
+func unavailableServer(t *testing.T, retryAfter string) (*httptest.Server, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	return server, &attempts
+}
+
+func TestRetryDeadline(t *testing.T) {
+	server, attempts := unavailableServer(t, "")
+	client, err := NewClientWithResponses(server.URL,
+		WithRetry(100, func(int) time.Duration { return 20 * time.Millisecond }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	rsp, err := client.GetOrderIdWithResponse(ctx, "234578", &GetOrderIdParams{})
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.StatusCode() != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the last 503", rsp.StatusCode())
+	}
+	if elapsed > 150*time.Millisecond {
+		t.Errorf("the retries took %s, past the deadline", elapsed)
+	}
+	if n := atomic.LoadInt32(attempts); n < 2 || n > 6 {
+		t.Errorf("got %d attempts, want the ones which fit in the deadline", n)
+	}
+}
+
+func TestRetryAfterLimit(t *testing.T) {
+	// A Retry-After of an hour, on a request without a deadline, is only
+	// waited for up to the limit.
+	server, attempts := unavailableServer(t, "3600")
+	client, err := NewClientWithResponses(server.URL,
+		WithRetry(2, func(int) time.Duration { return 0 }, WithMaxRetryAfter(20*time.Millisecond)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	rsp, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{})
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.StatusCode() != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want the last 503", rsp.StatusCode())
+	}
+	if n := atomic.LoadInt32(attempts); n != 3 {
+		t.Errorf("got %d attempts, want 3", n)
+	}
+	if elapsed < 40*time.Millisecond || elapsed > time.Second {
+		t.Errorf("the retries took %s, want about 40ms", elapsed)
+	}
+}
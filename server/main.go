@@ -2,6 +2,9 @@ package main
 
 import (
 	"log"
+	"log/slog"
+	"os"
+	"time"
 
 	spec "article-openapi"
 
@@ -10,21 +13,39 @@ import (
 
 const address = ":8088"
 
-type server struct {
+// apiKeys maps the accepted API keys to the principals they belong to.
+var apiKeys = map[string]string{
+	"secret-key": "demo",
 }
 
-func (s server) PutOrderId(c echo.Context, id string) error {
-	var req spec.PutOrderIdJSONRequestBody
-	if err := c.Bind(&req); err != nil {
-		log.Fatal(err)
-	}
-	log.Printf("id: %v, req: %v", id, req)
-	return nil
+func validateAPIKey(key string) (string, bool) {
+	principal, ok := apiKeys[key]
+	return principal, ok
 }
 
 func main() {
 	e := echo.New()
-	spec.RegisterHandlers(e, server{})
+	// Respond to unknown routes with an Error too, like to the routes of the
+	// spec.
+	e.HTTPErrorHandler = spec.NewHTTPErrorHandler()
+	// The server is reached directly, so the client IP of logs and rate
+	// limits is the peer address, not whatever X-Forwarded-For claims.
+	e.IPExtractor = echo.ExtractIPDirect()
+	e.Use(spec.VersionMiddleware())
+	if err := spec.RegisterHandlersWithOptions(e, spec.NewInMemoryStore(),
+		spec.WithMiddleware(
+			spec.GzipMiddleware(),
+			spec.LoggingMiddleware(slog.New(slog.NewJSONHandler(os.Stderr, nil))),
+			spec.RateLimitMiddleware(spec.NewInMemoryLimiter(100, time.Minute), validateAPIKey),
+			spec.RequireAPIKey(validateAPIKey),
+		),
+		spec.WithValidation(),
+		spec.WithDisallowUnknownFields(),
+		spec.WithCORS(spec.CORSOptions{AllowedOrigins: []string{"http://localhost:3000"}}),
+		spec.WithIdempotency(spec.NewInMemoryIdempotencyStore()),
+	); err != nil {
+		log.Fatal(err)
+	}
 
 	e.Logger.Fatal(e.Start(address))
 }
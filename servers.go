@@ -0,0 +1,60 @@
+package spec
+
+import "strings"
+
+// The URLs of the servers of the spec, to pass to NewClient or
+// NewClientWithResponses. TestServers checks them against spec.yaml.
+const (
+	ServerLocal      = "http://localhost:8088"
+	ServerStaging    = "https://staging.api.example.com"
+	ServerProduction = "https://api.example.com"
+)
+
+// ServerInfo describes a server of the spec.
+type ServerInfo struct {
+	// Name is the description of the server in spec.yaml, e.g. Staging.
+	Name string
+	URL  string
+}
+
+// servers are the servers of the embedded spec.
+var servers = specServers()
+
+// specServers returns the servers of the embedded spec. It panics if the spec
+// can't be loaded, like specVersions.
+func specServers() []ServerInfo {
+	swagger, err := GetSwagger()
+	if err != nil {
+		panic("spec: error loading spec: " + err.Error())
+	}
+	infos := make([]ServerInfo, len(swagger.Servers))
+	for i, server := range swagger.Servers {
+		infos[i] = ServerInfo{Name: server.Description, URL: server.URL}
+	}
+	return infos
+}
+
+// Servers returns every server of the spec, in the order of spec.yaml.
+func Servers() []ServerInfo {
+	return append([]ServerInfo(nil), servers...)
+}
+
+// DefaultServer returns the URL of the first server of the spec, which is
+// the one clients use unless told otherwise.
+func DefaultServer() string {
+	if len(servers) == 0 {
+		return ServerLocal
+	}
+	return servers[0].URL
+}
+
+// ServerByName returns the URL of the server of the spec with name, e.g.
+// Staging, ignoring case, and whether there is one.
+func ServerByName(name string) (string, bool) {
+	for _, server := range servers {
+		if strings.EqualFold(server.Name, name) {
+			return server.URL, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,31 @@
+package spec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestServers(t *testing.T) {
+	want := []ServerInfo{
+		{Name: "Local", URL: ServerLocal},
+		{Name: "Staging", URL: ServerStaging},
+		{Name: "Production", URL: ServerProduction},
+	}
+	if got := Servers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got the servers %+v of the spec, want %+v", got, want)
+	}
+	if got := DefaultServer(); got != ServerLocal {
+		t.Errorf("got DefaultServer() = %q, want %q", got, ServerLocal)
+	}
+	for _, server := range want {
+		if got, ok := ServerByName(server.Name); !ok || got != server.URL {
+			t.Errorf("got ServerByName(%q) = %q, %v, want %q, true", server.Name, got, ok, server.URL)
+		}
+	}
+	if got, ok := ServerByName("staging"); !ok || got != ServerStaging {
+		t.Errorf("got ServerByName(%q) = %q, %v, want %q, true", "staging", got, ok, ServerStaging)
+	}
+	if _, ok := ServerByName("Test"); ok {
+		t.Errorf("got a server named Test")
+	}
+}
@@ -0,0 +1,45 @@
+package spec
+
+import (
+	_ "embed"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The templates directory overrides templates of oapi-codegen, e.g. for the
+// WithBaseURL it generates and the User-Agent NewClient sets.
+//
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen@v1.8.2 -templates templates -generate types,client,server -package spec -o gen.go spec.yaml
+
+// SpecVersion is the version of the API, as in info.version of spec.yaml,
+// and openAPIVersion the version of the OpenAPI Specification it is written
+// in, as in openapi.
+var SpecVersion, openAPIVersion = specVersions()
+
+// rawSpec is the OpenAPI specification gen.go is generated from.
+//
+//go:embed spec.yaml
+var rawSpec []byte
+
+// RawSpec returns the OpenAPI specification of the API, byte for byte as it
+// is in spec.yaml.
+func RawSpec() []byte {
+	return append([]byte(nil), rawSpec...)
+}
+
+// GetSwagger returns the OpenAPI specification of the API. Each call returns
+// a freshly parsed copy, which the caller is free to modify.
+func GetSwagger() (*openapi3.T, error) {
+	return openapi3.NewLoader().LoadFromData(rawSpec)
+}
+
+// specVersions returns info.version and openapi of the embedded spec. It
+// panics if the spec can't be loaded, as gen.go couldn't have been generated
+// from it either.
+func specVersions() (string, string) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		panic("spec: error loading spec: " + err.Error())
+	}
+	return swagger.Info.Version, swagger.OpenAPI
+}
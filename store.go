@@ -0,0 +1,430 @@
+package spec
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+	maxBatchSize     = 100
+)
+
+// NewInMemoryStore returns a ServerInterface which keeps orders in memory.
+// It is safe for concurrent use, which makes it a working backend for
+// integration tests and demos.
+func NewInMemoryStore() ServerInterface {
+	return &inMemoryStore{
+		orders:   make(map[string]Order),
+		modified: make(map[string]time.Time),
+	}
+}
+
+type inMemoryStore struct {
+	Probes
+	VersionHandler
+
+	mu     sync.Mutex
+	orders map[string]Order
+	// modified holds when each order was last modified, truncated to the
+	// second like the Last-Modified header.
+	modified map[string]time.Time
+}
+
+func (s *inMemoryStore) PostOrder(c echo.Context, params PostOrderParams) error {
+	var req PostOrderJSONRequestBody
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	order, invalid := newOrder(req.Item, req.Price, req.Total)
+	if invalid != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, *invalid)
+	}
+	id, err := s.create(order)
+	if err != nil {
+		return err
+	}
+
+	location := routePrefix(c.Request().Method, c.Path()) + "/order/" + id
+	c.Response().Header().Set(echo.HeaderLocation, location)
+	switch prefer := preferredReturn(params.Prefer); prefer {
+	case PreferReturnMinimal:
+		c.Response().Header().Set(PreferenceAppliedHeader, string(prefer))
+		return c.NoContent(http.StatusCreated)
+	case PreferReturnRepresentation:
+		c.Response().Header().Set(PreferenceAppliedHeader, string(prefer))
+	}
+	return c.JSON(http.StatusCreated, CreatedOrder{Id: id})
+}
+
+// create adds order with a new ID and returns the ID.
+func (s *inMemoryStore) create(order Order) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, err := s.newID()
+	if err != nil {
+		return "", err
+	}
+	order.Id = &id
+	s.orders[id] = order
+	s.modified[id] = modificationTime()
+	return id, nil
+}
+
+// newID returns a random order ID that is not taken yet. The caller must
+// hold s.mu.
+func (s *inMemoryStore) newID() (string, error) {
+	for {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		id := hex.EncodeToString(b)
+		if _, ok := s.orders[id]; !ok {
+			return id, nil
+		}
+	}
+}
+
+func (s *inMemoryStore) PutOrderId(c echo.Context, id string, params PutOrderIdParams) error {
+	req, err := bindPutOrderIdBody(c)
+	if err != nil {
+		return err
+	}
+	if req.Id != nil && *req.Id != id {
+		return c.JSON(http.StatusConflict, Error{
+			Code:    "id_mismatch",
+			Message: "order id " + *req.Id + " does not match the path id " + id,
+		})
+	}
+
+	order, invalid := newOrder(&req.Item, req.Price, req.Total)
+	if invalid != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, *invalid)
+	}
+	order.Id = &id
+	tag, err := etag(order)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if params.IfMatch != nil {
+		current, ok := s.orders[id]
+		if !ok || !etagMatches(*params.IfMatch, current) {
+			s.mu.Unlock()
+			return c.JSON(http.StatusPreconditionFailed, Error{
+				Code:    "precondition_failed",
+				Message: "order " + id + " does not match If-Match",
+			})
+		}
+	}
+	if params.IfNoneMatch != nil {
+		if current, ok := s.orders[id]; ok && etagNoneMatchFails(*params.IfNoneMatch, current) {
+			s.mu.Unlock()
+			message := "order " + id + " matches If-None-Match"
+			if strings.TrimSpace(*params.IfNoneMatch) == "*" {
+				message = "order " + id + " already exists"
+			}
+			return c.JSON(http.StatusPreconditionFailed, Error{
+				Code:    "precondition_failed",
+				Message: message,
+			})
+		}
+	}
+	s.orders[id] = order
+	s.modified[id] = modificationTime()
+	s.mu.Unlock()
+
+	c.Response().Header().Set("ETag", tag)
+	switch prefer := preferredReturn(params.Prefer); prefer {
+	case PreferReturnRepresentation:
+		c.Response().Header().Set(PreferenceAppliedHeader, string(prefer))
+		return c.JSON(http.StatusCreated, order)
+	case PreferReturnMinimal:
+		c.Response().Header().Set(PreferenceAppliedHeader, string(prefer))
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func (s *inMemoryStore) GetOrderId(c echo.Context, id string, params GetOrderIdParams) error {
+	var fields []string
+	if params.Fields != nil {
+		fields = *params.Fields
+	}
+	if unknown := unknownOrderFields(fields); len(unknown) > 0 {
+		details := make([]string, len(unknown))
+		for i, name := range unknown {
+			details[i] = "fields: unknown field " + name
+		}
+		return c.JSON(http.StatusBadRequest, Error{
+			Code:    "invalid_fields",
+			Message: "fields must only name fields of an order",
+			Details: &details,
+		})
+	}
+
+	s.mu.Lock()
+	order, ok := s.orders[id]
+	modified := s.modified[id]
+	s.mu.Unlock()
+
+	if !ok {
+		return orderNotFound(c, id)
+	}
+
+	tag, err := etag(order)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set("ETag", tag)
+	c.Response().Header().Set(echo.HeaderLastModified, HTTPDate(modified).String())
+	// Orders may change at any time, so caches must revalidate them.
+	c.Response().Header().Set("Cache-Control", "no-cache")
+	notModified := params.IfModifiedSince != nil && !modified.After(time.Time(*params.IfModifiedSince))
+	if params.IfNoneMatch != nil {
+		// If-None-Match takes precedence over If-Modified-Since.
+		notModified = etagListContains(*params.IfNoneMatch, tag, true)
+	}
+	if notModified {
+		return c.NoContent(http.StatusNotModified)
+	}
+	body, err := selectOrderFields(order, fields)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, body)
+}
+
+func (s *inMemoryStore) PatchOrderId(c echo.Context, id string) error {
+	var req PatchOrderIdJSONRequestBody
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if _, _, invalid := reconcilePrice(req.Price, req.Total); invalid != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, *invalid)
+	}
+
+	s.mu.Lock()
+	order, ok := s.orders[id]
+	if ok {
+		order = req.Apply(order)
+		s.orders[id] = order
+		s.modified[id] = modificationTime()
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return orderNotFound(c, id)
+	}
+
+	tag, err := etag(order)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set("ETag", tag)
+	return c.JSON(http.StatusOK, order)
+}
+
+func (s *inMemoryStore) DeleteOrderId(c echo.Context, id string) error {
+	s.mu.Lock()
+	_, ok := s.orders[id]
+	delete(s.orders, id)
+	delete(s.modified, id)
+	s.mu.Unlock()
+
+	if !ok {
+		return orderNotFound(c, id)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *inMemoryStore) ListOrders(c echo.Context, params ListOrdersParams) error {
+	limit := defaultListLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxListLimit {
+		return c.JSON(http.StatusBadRequest, Error{
+			Code:    "invalid_limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", maxListLimit),
+		})
+	}
+
+	// The cursor is the ID of the last order of the previous page.
+	var after string
+	if params.Cursor != nil {
+		b, err := base64.RawURLEncoding.DecodeString(*params.Cursor)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, Error{
+				Code:    "invalid_cursor",
+				Message: "cursor is malformed",
+			})
+		}
+		after = string(b)
+	}
+
+	s.mu.Lock()
+	ids := s.sortedIDs(after)
+	resp := OrderList{Orders: []Order{}}
+	for _, id := range ids {
+		if len(resp.Orders) == limit {
+			cursor := base64.RawURLEncoding.EncodeToString([]byte(*resp.Orders[limit-1].Id))
+			resp.NextCursor = &cursor
+			break
+		}
+		resp.Orders = append(resp.Orders, s.orders[id])
+	}
+	s.mu.Unlock()
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// sortedIDs returns the IDs of the orders after the ID after, in order. The
+// caller must hold s.mu.
+func (s *inMemoryStore) sortedIDs(after string) []string {
+	ids := make([]string, 0, len(s.orders))
+	for id := range s.orders {
+		if id > after {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *inMemoryStore) ExportOrders(c echo.Context) error {
+	// Only the IDs are taken up front, so that the lock isn't held while
+	// the orders are written.
+	s.mu.Lock()
+	ids := s.sortedIDs("")
+	s.mu.Unlock()
+
+	ctx := c.Request().Context()
+	return WriteOrdersNDJSON(c.Response(), func(yield func(Order, error) bool) {
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				yield(Order{}, err)
+				return
+			}
+			s.mu.Lock()
+			order, ok := s.orders[id]
+			s.mu.Unlock()
+			// Orders deleted since the export started are left out.
+			if ok && !yield(order, nil) {
+				return
+			}
+		}
+	})
+}
+
+func (s *inMemoryStore) BatchCreateOrders(c echo.Context) error {
+	var req struct {
+		Orders []json.RawMessage `json:"orders"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if len(req.Orders) == 0 || len(req.Orders) > maxBatchSize {
+		return c.JSON(http.StatusBadRequest, Error{
+			Code:    "invalid_batch",
+			Message: fmt.Sprintf("a batch must have between 1 and %d orders", maxBatchSize),
+		})
+	}
+
+	resp := BatchCreateOrdersOutput{Results: make([]BatchCreateOrdersResult, len(req.Orders))}
+	for i, raw := range req.Orders {
+		input, invalid := decodeOrderInput(c.Request().Context(), raw)
+		if invalid != nil {
+			resp.Results[i].Error = invalid
+			continue
+		}
+		order, invalid := newOrder(input.Item, input.Price, input.Total)
+		if invalid != nil {
+			resp.Results[i].Error = invalid
+			continue
+		}
+		id, err := s.create(order)
+		if err != nil {
+			return err
+		}
+		resp.Results[i].Id = &id
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// modificationTime returns the current time, as precise as the
+// Last-Modified header.
+func modificationTime() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// etag returns the entity tag of order, derived from its content.
+func etag(order Order) (string, error) {
+	b, err := json.Marshal(order)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`, nil
+}
+
+// etagMatches reports whether the If-Match header value ifMatch, a list of
+// entity tags or "*", matches the current version of order.
+func etagMatches(ifMatch string, order Order) bool {
+	tag, err := etag(order)
+	if err != nil {
+		return false
+	}
+	return etagListContains(ifMatch, tag, false)
+}
+
+// etagNoneMatchFails reports whether the If-None-Match header value
+// ifNoneMatch, a list of entity tags or "*", matches the current version of
+// order, so that a write with it must fail.
+func etagNoneMatchFails(ifNoneMatch string, order Order) bool {
+	tag, err := etag(order)
+	if err != nil {
+		return true
+	}
+	return etagListContains(ifNoneMatch, tag, true)
+}
+
+// etagListContains reports whether list, the value of an If-Match or
+// If-None-Match header, is "*" or contains tag. With weak, as for
+// If-None-Match, weak entity tags like W/"..." count as well.
+func etagListContains(list, tag string, weak bool) bool {
+	if strings.TrimSpace(list) == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func orderNotFound(c echo.Context, id string) error {
+	return c.JSON(http.StatusNotFound, Error{
+		Code:    "not_found",
+		Message: "order " + id + " not found",
+	})
+}
@@ -0,0 +1,79 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestPutOrderIdPreconditions checks the If-Match and If-None-Match headers
+// of PutOrderId, against an order created with a known ETag.
+func TestPutOrderIdPreconditions(t *testing.T) {
+	const order = `{"item":"Tea Table Green","price":1499}`
+	for _, tt := range []struct {
+		name        string
+		id          string
+		header      map[string]string
+		wantStatus  int
+		wantMessage string
+	}{
+		{"If-Match with the ETag", "234578", map[string]string{"If-Match": "ETAG"}, http.StatusCreated, ""},
+		{"If-Match with another ETag", "234578", map[string]string{"If-Match": `"other"`}, http.StatusPreconditionFailed,
+			"order 234578 does not match If-Match"},
+		{"If-Match of a new order", "234579", map[string]string{"If-Match": "*"}, http.StatusPreconditionFailed,
+			"order 234579 does not match If-Match"},
+		{"If-None-Match * of a new order", "234579", map[string]string{"If-None-Match": "*"}, http.StatusCreated, ""},
+		{"If-None-Match * of an order", "234578", map[string]string{"If-None-Match": "*"}, http.StatusPreconditionFailed,
+			"order 234578 already exists"},
+		{"If-None-Match with the ETag", "234578", map[string]string{"If-None-Match": `"other", ETAG`}, http.StatusPreconditionFailed,
+			"order 234578 matches If-None-Match"},
+		{"If-None-Match with the weak ETag", "234578", map[string]string{"If-None-Match": "W/ETAG"}, http.StatusPreconditionFailed,
+			"order 234578 matches If-None-Match"},
+		{"If-None-Match with another ETag", "234578", map[string]string{"If-None-Match": `"other"`}, http.StatusCreated, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			if err := RegisterHandlersWithOptions(e, NewInMemoryStore()); err != nil {
+				t.Fatal(err)
+			}
+			put := func(id string, header map[string]string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPut, "/order/"+id, strings.NewReader(order))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				for name, value := range header {
+					req.Header.Set(name, value)
+				}
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				return rec
+			}
+			rec := put("234578", nil)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("got status %d creating the order, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+			tag := rec.Header().Get("ETag")
+
+			header := make(map[string]string, len(tt.header))
+			for name, value := range tt.header {
+				header[name] = strings.ReplaceAll(value, "ETAG", tag)
+			}
+			rec = put(tt.id, header)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantMessage == "" {
+				return
+			}
+			var rsp Error
+			if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Code != "precondition_failed" || rsp.Message != tt.wantMessage {
+				t.Errorf("got the Error %s, want precondition_failed: %s", rec.Body, tt.wantMessage)
+			}
+		})
+	}
+}
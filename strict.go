@@ -0,0 +1,993 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// This file provides a strict variant of ServerInterface: handlers receive
+// their parameters and decoded body as a typed request object and return a
+// typed response object, which takes care of the status code, headers and
+// body. oapi-codegen v1.8.2 has no strict mode, so it is written by hand:
+// TestStrictResponses checks that it has a response object for every
+// response of spec.yaml, and TestStrictHandler that every operation goes
+// through NewStrictHandler.
+
+type GetHealthzRequestObject struct{}
+
+type GetHealthzResponseObject interface {
+	VisitGetHealthzResponse(w http.ResponseWriter) error
+}
+
+type GetHealthz200JSONResponse Health
+
+func (response GetHealthz200JSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusOK, response)
+}
+
+type GetHealthz429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type GetHealthz429JSONResponse struct {
+	Body    Error
+	Headers GetHealthz429ResponseHeaders
+}
+
+func (response GetHealthz429JSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type GetHealthzdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetHealthzdefaultJSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type PostOrderRequestObject struct {
+	Params PostOrderParams
+	Body   *PostOrderJSONRequestBody
+}
+
+type PostOrderResponseObject interface {
+	VisitPostOrderResponse(w http.ResponseWriter) error
+}
+
+type PostOrder201ResponseHeaders struct {
+	Location          string
+	PreferenceApplied string
+}
+
+type PostOrder201JSONResponse struct {
+	Body    CreatedOrder
+	Headers PostOrder201ResponseHeaders
+}
+
+func (response PostOrder201JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "Location", response.Headers.Location)
+	setOptionalHeader(w, "Preference-Applied", response.Headers.PreferenceApplied)
+	return writeJSON(w, http.StatusCreated, response.Body)
+}
+
+type PostOrder201Response struct {
+	Headers PostOrder201ResponseHeaders
+}
+
+func (response PostOrder201Response) VisitPostOrderResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "Location", response.Headers.Location)
+	setOptionalHeader(w, "Preference-Applied", response.Headers.PreferenceApplied)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+type PostOrder400JSONResponse Error
+
+func (response PostOrder400JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type PostOrder401JSONResponse Error
+
+func (response PostOrder401JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type PostOrder409JSONResponse Error
+
+func (response PostOrder409JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusConflict, response)
+}
+
+type PostOrder413JSONResponse Error
+
+func (response PostOrder413JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusRequestEntityTooLarge, response)
+}
+
+type PostOrder415JSONResponse Error
+
+func (response PostOrder415JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnsupportedMediaType, response)
+}
+
+type PostOrder422JSONResponse Error
+
+func (response PostOrder422JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnprocessableEntity, response)
+}
+
+type PostOrder429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostOrder429JSONResponse struct {
+	Body    Error
+	Headers PostOrder429ResponseHeaders
+}
+
+func (response PostOrder429JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type PostOrder500JSONResponse Error
+
+func (response PostOrder500JSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type PostOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PostOrderdefaultJSONResponse) VisitPostOrderResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type ListOrdersRequestObject struct {
+	Params ListOrdersParams
+}
+
+type ListOrdersResponseObject interface {
+	VisitListOrdersResponse(w http.ResponseWriter) error
+}
+
+type ListOrders200JSONResponse OrderList
+
+func (response ListOrders200JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusOK, response)
+}
+
+type ListOrders400JSONResponse Error
+
+func (response ListOrders400JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type ListOrders401JSONResponse Error
+
+func (response ListOrders401JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type ListOrders429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type ListOrders429JSONResponse struct {
+	Body    Error
+	Headers ListOrders429ResponseHeaders
+}
+
+func (response ListOrders429JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type ListOrders500JSONResponse Error
+
+func (response ListOrders500JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type ListOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListOrdersdefaultJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type ExportOrdersRequestObject struct{}
+
+type ExportOrdersResponseObject interface {
+	VisitExportOrdersResponse(w http.ResponseWriter) error
+}
+
+type ExportOrders200ApplicationxNdjsonResponse struct {
+	Body iter.Seq2[Order, error]
+}
+
+func (response ExportOrders200ApplicationxNdjsonResponse) VisitExportOrdersResponse(w http.ResponseWriter) error {
+	return WriteOrdersNDJSON(w, response.Body)
+}
+
+type ExportOrders401JSONResponse Error
+
+func (response ExportOrders401JSONResponse) VisitExportOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type ExportOrders429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type ExportOrders429JSONResponse struct {
+	Body    Error
+	Headers ExportOrders429ResponseHeaders
+}
+
+func (response ExportOrders429JSONResponse) VisitExportOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type ExportOrders500JSONResponse Error
+
+func (response ExportOrders500JSONResponse) VisitExportOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type BatchCreateOrdersRequestObject struct {
+	Body *BatchCreateOrdersJSONRequestBody
+	// Errors has, at the index of each order of Body which is invalid, why,
+	// so that it fails alone: the order is the zero OrderInput, and its
+	// result should be the Error.
+	Errors []*Error
+}
+
+type BatchCreateOrdersResponseObject interface {
+	VisitBatchCreateOrdersResponse(w http.ResponseWriter) error
+}
+
+type BatchCreateOrders200JSONResponse BatchCreateOrdersOutput
+
+func (response BatchCreateOrders200JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusOK, response)
+}
+
+type BatchCreateOrders400JSONResponse Error
+
+func (response BatchCreateOrders400JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type BatchCreateOrders401JSONResponse Error
+
+func (response BatchCreateOrders401JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type BatchCreateOrders413JSONResponse Error
+
+func (response BatchCreateOrders413JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusRequestEntityTooLarge, response)
+}
+
+type BatchCreateOrders415JSONResponse Error
+
+func (response BatchCreateOrders415JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnsupportedMediaType, response)
+}
+
+type BatchCreateOrders429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type BatchCreateOrders429JSONResponse struct {
+	Body    Error
+	Headers BatchCreateOrders429ResponseHeaders
+}
+
+func (response BatchCreateOrders429JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type BatchCreateOrders500JSONResponse Error
+
+func (response BatchCreateOrders500JSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type BatchCreateOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response BatchCreateOrdersdefaultJSONResponse) VisitBatchCreateOrdersResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type DeleteOrderIdRequestObject struct {
+	Id string
+}
+
+type DeleteOrderIdResponseObject interface {
+	VisitDeleteOrderIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrderId204Response struct{}
+
+func (response DeleteOrderId204Response) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+type DeleteOrderId400JSONResponse Error
+
+func (response DeleteOrderId400JSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type DeleteOrderId401JSONResponse Error
+
+func (response DeleteOrderId401JSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type DeleteOrderId404JSONResponse Error
+
+func (response DeleteOrderId404JSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusNotFound, response)
+}
+
+type DeleteOrderId429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type DeleteOrderId429JSONResponse struct {
+	Body    Error
+	Headers DeleteOrderId429ResponseHeaders
+}
+
+func (response DeleteOrderId429JSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type DeleteOrderId500JSONResponse Error
+
+func (response DeleteOrderId500JSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type DeleteOrderIddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeleteOrderIddefaultJSONResponse) VisitDeleteOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type GetOrderIdRequestObject struct {
+	Id     string
+	Params GetOrderIdParams
+}
+
+type GetOrderIdResponseObject interface {
+	VisitGetOrderIdResponse(w http.ResponseWriter) error
+}
+
+type GetOrderId200ResponseHeaders struct {
+	ETag         string
+	LastModified string
+	CacheControl string
+}
+
+type GetOrderId200JSONResponse struct {
+	Body Order
+	// Fields are the only fields of Body the response has, like the fields
+	// parameter of the request asks; if it names none, it has them all.
+	Fields  []string
+	Headers GetOrderId200ResponseHeaders
+}
+
+func (response GetOrderId200JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	body, err := selectOrderFields(response.Body, response.Fields)
+	if err != nil {
+		return err
+	}
+	setOptionalHeader(w, "ETag", response.Headers.ETag)
+	setOptionalHeader(w, "Last-Modified", response.Headers.LastModified)
+	setOptionalHeader(w, "Cache-Control", response.Headers.CacheControl)
+	return writeJSON(w, http.StatusOK, body)
+}
+
+type GetOrderId304ResponseHeaders struct {
+	ETag         string
+	LastModified string
+	CacheControl string
+}
+
+type GetOrderId304Response struct {
+	Headers GetOrderId304ResponseHeaders
+}
+
+func (response GetOrderId304Response) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "ETag", response.Headers.ETag)
+	setOptionalHeader(w, "Last-Modified", response.Headers.LastModified)
+	setOptionalHeader(w, "Cache-Control", response.Headers.CacheControl)
+	w.WriteHeader(http.StatusNotModified)
+	return nil
+}
+
+type GetOrderId400JSONResponse Error
+
+func (response GetOrderId400JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type GetOrderId401JSONResponse Error
+
+func (response GetOrderId401JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type GetOrderId404JSONResponse Error
+
+func (response GetOrderId404JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusNotFound, response)
+}
+
+type GetOrderId429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type GetOrderId429JSONResponse struct {
+	Body    Error
+	Headers GetOrderId429ResponseHeaders
+}
+
+func (response GetOrderId429JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type GetOrderId500JSONResponse Error
+
+func (response GetOrderId500JSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type GetOrderIddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderIddefaultJSONResponse) VisitGetOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type PatchOrderIdRequestObject struct {
+	Id   string
+	Body *PatchOrderIdJSONRequestBody
+}
+
+type PatchOrderIdResponseObject interface {
+	VisitPatchOrderIdResponse(w http.ResponseWriter) error
+}
+
+type PatchOrderId200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchOrderId200JSONResponse struct {
+	Body    Order
+	Headers PatchOrderId200ResponseHeaders
+}
+
+func (response PatchOrderId200JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "ETag", response.Headers.ETag)
+	return writeJSON(w, http.StatusOK, response.Body)
+}
+
+type PatchOrderId400JSONResponse Error
+
+func (response PatchOrderId400JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type PatchOrderId401JSONResponse Error
+
+func (response PatchOrderId401JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type PatchOrderId404JSONResponse Error
+
+func (response PatchOrderId404JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusNotFound, response)
+}
+
+type PatchOrderId413JSONResponse Error
+
+func (response PatchOrderId413JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusRequestEntityTooLarge, response)
+}
+
+type PatchOrderId415JSONResponse Error
+
+func (response PatchOrderId415JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnsupportedMediaType, response)
+}
+
+type PatchOrderId429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PatchOrderId429JSONResponse struct {
+	Body    Error
+	Headers PatchOrderId429ResponseHeaders
+}
+
+func (response PatchOrderId429JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type PatchOrderId500JSONResponse Error
+
+func (response PatchOrderId500JSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type PatchOrderIddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PatchOrderIddefaultJSONResponse) VisitPatchOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type PutOrderIdRequestObject struct {
+	Id     string
+	Params PutOrderIdParams
+	Body   *PutOrderIdJSONRequestBody
+}
+
+type PutOrderIdResponseObject interface {
+	VisitPutOrderIdResponse(w http.ResponseWriter) error
+}
+
+type PutOrderId201ResponseHeaders struct {
+	ETag              string
+	PreferenceApplied string
+}
+
+type PutOrderId201Response struct {
+	Headers PutOrderId201ResponseHeaders
+}
+
+func (response PutOrderId201Response) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "ETag", response.Headers.ETag)
+	setOptionalHeader(w, "Preference-Applied", response.Headers.PreferenceApplied)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+type PutOrderId201JSONResponse struct {
+	Body    Order
+	Headers PutOrderId201ResponseHeaders
+}
+
+func (response PutOrderId201JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	setOptionalHeader(w, "ETag", response.Headers.ETag)
+	setOptionalHeader(w, "Preference-Applied", response.Headers.PreferenceApplied)
+	return writeJSON(w, http.StatusCreated, response.Body)
+}
+
+type PutOrderId400JSONResponse Error
+
+func (response PutOrderId400JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusBadRequest, response)
+}
+
+type PutOrderId401JSONResponse Error
+
+func (response PutOrderId401JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnauthorized, response)
+}
+
+type PutOrderId409JSONResponse Error
+
+func (response PutOrderId409JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusConflict, response)
+}
+
+type PutOrderId412JSONResponse Error
+
+func (response PutOrderId412JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusPreconditionFailed, response)
+}
+
+type PutOrderId413JSONResponse Error
+
+func (response PutOrderId413JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusRequestEntityTooLarge, response)
+}
+
+type PutOrderId415JSONResponse Error
+
+func (response PutOrderId415JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusUnsupportedMediaType, response)
+}
+
+type PutOrderId429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PutOrderId429JSONResponse struct {
+	Body    Error
+	Headers PutOrderId429ResponseHeaders
+}
+
+func (response PutOrderId429JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type PutOrderId500JSONResponse Error
+
+func (response PutOrderId500JSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusInternalServerError, response)
+}
+
+type PutOrderIddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PutOrderIddefaultJSONResponse) VisitPutOrderIdResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type GetReadyzRequestObject struct{}
+
+type GetReadyzResponseObject interface {
+	VisitGetReadyzResponse(w http.ResponseWriter) error
+}
+
+type GetReadyz200JSONResponse Health
+
+func (response GetReadyz200JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusOK, response)
+}
+
+type GetReadyz429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type GetReadyz429JSONResponse struct {
+	Body    Error
+	Headers GetReadyz429ResponseHeaders
+}
+
+func (response GetReadyz429JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type GetReadyz503JSONResponse Error
+
+func (response GetReadyz503JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusServiceUnavailable, response)
+}
+
+type GetReadyzdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetReadyzdefaultJSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+type GetVersionRequestObject struct{}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse Version
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	return writeJSON(w, http.StatusOK, response)
+}
+
+type GetVersion429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type GetVersion429JSONResponse struct {
+	Body    Error
+	Headers GetVersion429ResponseHeaders
+}
+
+func (response GetVersion429JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", strconv.Itoa(response.Headers.RetryAfter))
+	return writeJSON(w, http.StatusTooManyRequests, response.Body)
+}
+
+type GetVersiondefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetVersiondefaultJSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	return writeJSON(w, response.StatusCode, response.Body)
+}
+
+// StrictServerInterface represents all server handlers, taking typed request
+// objects and returning typed response objects.
+type StrictServerInterface interface {
+	// Check that the server is alive
+	// (GET /healthz)
+	GetHealthz(ctx context.Context, request GetHealthzRequestObject) (GetHealthzResponseObject, error)
+	// Create an order with a server-assigned ID
+	// (POST /order)
+	PostOrder(ctx context.Context, request PostOrderRequestObject) (PostOrderResponseObject, error)
+	// Delete an order
+	// (DELETE /order/{id})
+	DeleteOrderId(ctx context.Context, request DeleteOrderIdRequestObject) (DeleteOrderIdResponseObject, error)
+	// Get an order
+	// (GET /order/{id})
+	GetOrderId(ctx context.Context, request GetOrderIdRequestObject) (GetOrderIdResponseObject, error)
+	// Update some fields of an order
+	// (PATCH /order/{id})
+	PatchOrderId(ctx context.Context, request PatchOrderIdRequestObject) (PatchOrderIdResponseObject, error)
+	// Create an order
+	// (PUT /order/{id})
+	PutOrderId(ctx context.Context, request PutOrderIdRequestObject) (PutOrderIdResponseObject, error)
+	// List orders
+	// (GET /orders)
+	ListOrders(ctx context.Context, request ListOrdersRequestObject) (ListOrdersResponseObject, error)
+	// Export every order as newline-delimited JSON
+	// (GET /orders/export)
+	ExportOrders(ctx context.Context, request ExportOrdersRequestObject) (ExportOrdersResponseObject, error)
+	// Create several orders with server-assigned IDs
+	// (POST /orders:batch)
+	BatchCreateOrders(ctx context.Context, request BatchCreateOrdersRequestObject) (BatchCreateOrdersResponseObject, error)
+	// Check that the server is ready to handle requests
+	// (GET /readyz)
+	GetReadyz(ctx context.Context, request GetReadyzRequestObject) (GetReadyzResponseObject, error)
+	// Get the version of the API
+	// (GET /version)
+	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
+}
+
+// NewStrictHandler adapts a StrictServerInterface to a ServerInterface, which
+// can be passed to RegisterHandlers.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+	return &strictHandler{ssi: ssi}
+}
+
+type strictHandler struct {
+	ssi StrictServerInterface
+}
+
+// GetHealthz operation middleware
+func (sh *strictHandler) GetHealthz(ctx echo.Context) error {
+	var request GetHealthzRequestObject
+
+	response, err := sh.ssi.GetHealthz(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitGetHealthzResponse(ctx.Response())
+}
+
+// PostOrder operation middleware
+func (sh *strictHandler) PostOrder(ctx echo.Context, params PostOrderParams) error {
+	request := PostOrderRequestObject{Params: params}
+
+	var body PostOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.PostOrder(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitPostOrderResponse(ctx.Response())
+}
+
+// DeleteOrderId operation middleware
+func (sh *strictHandler) DeleteOrderId(ctx echo.Context, id string) error {
+	request := DeleteOrderIdRequestObject{Id: id}
+
+	response, err := sh.ssi.DeleteOrderId(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitDeleteOrderIdResponse(ctx.Response())
+}
+
+// GetOrderId operation middleware
+func (sh *strictHandler) GetOrderId(ctx echo.Context, id string, params GetOrderIdParams) error {
+	request := GetOrderIdRequestObject{Id: id, Params: params}
+
+	response, err := sh.ssi.GetOrderId(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitGetOrderIdResponse(ctx.Response())
+}
+
+// PatchOrderId operation middleware
+func (sh *strictHandler) PatchOrderId(ctx echo.Context, id string) error {
+	request := PatchOrderIdRequestObject{Id: id}
+
+	var body PatchOrderIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.PatchOrderId(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitPatchOrderIdResponse(ctx.Response())
+}
+
+// PutOrderId operation middleware
+func (sh *strictHandler) PutOrderId(ctx echo.Context, id string, params PutOrderIdParams) error {
+	request := PutOrderIdRequestObject{Id: id, Params: params}
+
+	body, err := bindPutOrderIdBody(ctx)
+	if err != nil {
+		return err
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.PutOrderId(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitPutOrderIdResponse(ctx.Response())
+}
+
+// ListOrders operation middleware
+func (sh *strictHandler) ListOrders(ctx echo.Context, params ListOrdersParams) error {
+	request := ListOrdersRequestObject{Params: params}
+
+	response, err := sh.ssi.ListOrders(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitListOrdersResponse(ctx.Response())
+}
+
+// ExportOrders operation middleware
+func (sh *strictHandler) ExportOrders(ctx echo.Context) error {
+	var request ExportOrdersRequestObject
+
+	response, err := sh.ssi.ExportOrders(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitExportOrdersResponse(ctx.Response())
+}
+
+// BatchCreateOrders operation middleware
+func (sh *strictHandler) BatchCreateOrders(ctx echo.Context) error {
+	var request BatchCreateOrdersRequestObject
+
+	// The orders are decoded one by one, like the ServerInterface of
+	// NewInMemoryStore does, so that an invalid one doesn't fail the batch.
+	var raw struct {
+		Orders []json.RawMessage `json:"orders"`
+	}
+	if err := ctx.Bind(&raw); err != nil {
+		return err
+	}
+	body := BatchCreateOrdersJSONRequestBody{Orders: make([]OrderInput, len(raw.Orders))}
+	request.Errors = make([]*Error, len(raw.Orders))
+	for i, order := range raw.Orders {
+		body.Orders[i], request.Errors[i] = decodeOrderInput(ctx.Request().Context(), order)
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.BatchCreateOrders(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitBatchCreateOrdersResponse(ctx.Response())
+}
+
+// GetReadyz operation middleware
+func (sh *strictHandler) GetReadyz(ctx echo.Context) error {
+	var request GetReadyzRequestObject
+
+	response, err := sh.ssi.GetReadyz(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitGetReadyzResponse(ctx.Response())
+}
+
+// GetVersion operation middleware
+func (sh *strictHandler) GetVersion(ctx echo.Context) error {
+	var request GetVersionRequestObject
+
+	response, err := sh.ssi.GetVersion(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return response.VisitGetVersionResponse(ctx.Response())
+}
+
+// writeJSON writes v as the JSON body of a response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// setOptionalHeader sets the header name of a response to value, unless
+// value is empty, for headers which responses don't always have.
+func setOptionalHeader(w http.ResponseWriter, name, value string) {
+	if value != "" {
+		w.Header().Set(name, value)
+	}
+}
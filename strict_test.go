@@ -0,0 +1,432 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// strictBatchServer is a StrictServerInterface which only implements
+// BatchCreateOrders, creating every valid order with its index as ID.
+type strictBatchServer struct {
+	StrictServerInterface
+}
+
+func (strictBatchServer) BatchCreateOrders(ctx context.Context, request BatchCreateOrdersRequestObject) (BatchCreateOrdersResponseObject, error) {
+	rsp := BatchCreateOrdersOutput{Results: make([]BatchCreateOrdersResult, len(request.Body.Orders))}
+	for i := range request.Body.Orders {
+		if request.Errors[i] != nil {
+			rsp.Results[i].Error = request.Errors[i]
+			continue
+		}
+		id := string(rune('a' + i))
+		rsp.Results[i].Id = &id
+	}
+	return BatchCreateOrders200JSONResponse(rsp), nil
+}
+
+// TestStrictBatchCreateOrders checks that an invalid order of a batch fails
+// alone through NewStrictHandler too.
+func TestStrictBatchCreateOrders(t *testing.T) {
+	e := echo.New()
+	if err := RegisterHandlersWithOptions(e, NewStrictHandler(strictBatchServer{})); err != nil {
+		t.Fatal(err)
+	}
+	body := `{"orders":[
+		{"item":"Tea Table Green","total":{"amount":1499,"currency":"EUR"}},
+		{"item":"Tea Table Green","total":{"amount":1499,"currency":"XXX"}},
+		{"item":"Tea Table Red","price":1499}
+	]}`
+	req := httptest.NewRequest(http.MethodPost, "/orders:batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var rsp BatchCreateOrdersOutput
+	if err := json.Unmarshal(rec.Body.Bytes(), &rsp); err != nil {
+		t.Fatal(err)
+	}
+	if len(rsp.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(rsp.Results))
+	}
+	for i, wantErr := range []bool{false, true, false} {
+		if got := rsp.Results[i]; (got.Error != nil) != wantErr || (got.Id != nil) == wantErr {
+			t.Errorf("got the result %d %+v, want an error: %v", i, got, wantErr)
+		}
+	}
+}
+
+// strictServer is a StrictServerInterface which records the request object
+// of the last call and responds with response.
+type strictServer struct {
+	request  interface{}
+	response interface{}
+}
+
+func (s *strictServer) GetHealthz(ctx context.Context, request GetHealthzRequestObject) (GetHealthzResponseObject, error) {
+	s.request = request
+	return s.response.(GetHealthzResponseObject), nil
+}
+
+func (s *strictServer) PostOrder(ctx context.Context, request PostOrderRequestObject) (PostOrderResponseObject, error) {
+	s.request = request
+	return s.response.(PostOrderResponseObject), nil
+}
+
+func (s *strictServer) DeleteOrderId(ctx context.Context, request DeleteOrderIdRequestObject) (DeleteOrderIdResponseObject, error) {
+	s.request = request
+	return s.response.(DeleteOrderIdResponseObject), nil
+}
+
+func (s *strictServer) GetOrderId(ctx context.Context, request GetOrderIdRequestObject) (GetOrderIdResponseObject, error) {
+	s.request = request
+	return s.response.(GetOrderIdResponseObject), nil
+}
+
+func (s *strictServer) PatchOrderId(ctx context.Context, request PatchOrderIdRequestObject) (PatchOrderIdResponseObject, error) {
+	s.request = request
+	return s.response.(PatchOrderIdResponseObject), nil
+}
+
+func (s *strictServer) PutOrderId(ctx context.Context, request PutOrderIdRequestObject) (PutOrderIdResponseObject, error) {
+	s.request = request
+	return s.response.(PutOrderIdResponseObject), nil
+}
+
+func (s *strictServer) ListOrders(ctx context.Context, request ListOrdersRequestObject) (ListOrdersResponseObject, error) {
+	s.request = request
+	return s.response.(ListOrdersResponseObject), nil
+}
+
+func (s *strictServer) ExportOrders(ctx context.Context, request ExportOrdersRequestObject) (ExportOrdersResponseObject, error) {
+	s.request = request
+	return s.response.(ExportOrdersResponseObject), nil
+}
+
+func (s *strictServer) BatchCreateOrders(ctx context.Context, request BatchCreateOrdersRequestObject) (BatchCreateOrdersResponseObject, error) {
+	s.request = request
+	return s.response.(BatchCreateOrdersResponseObject), nil
+}
+
+func (s *strictServer) GetReadyz(ctx context.Context, request GetReadyzRequestObject) (GetReadyzResponseObject, error) {
+	s.request = request
+	return s.response.(GetReadyzResponseObject), nil
+}
+
+func (s *strictServer) GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error) {
+	s.request = request
+	return s.response.(GetVersionResponseObject), nil
+}
+
+// TestStrictHandler sends a request for every operation through
+// NewStrictHandler, and checks the request object the StrictServerInterface
+// gets and the response its response object makes.
+func TestStrictHandler(t *testing.T) {
+	green, red := OrderItemTeaTableGreen, OrderItemTeaTableRed
+	id, price, amount, currency := "234578", 1499, int64(1499), CurrencyEUR
+	total := &Money{Amount: 1499, Currency: CurrencyEUR}
+	key, tag, limit, cursor := "key-1", `"abc"`, 20, "next"
+	minimal, representation := PreferReturnMinimal, PreferReturnRepresentation
+	fields := []string{"price"}
+	since := HTTPDate(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	order := Order{Id: &id, Item: &green, Price: &price, Total: total}
+	failure := Error{Code: "not_found", Message: "no order 234578"}
+
+	tests := []struct {
+		op          string
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		header      map[string]string
+		wantRequest interface{}
+		response    interface{}
+		wantStatus  int
+		wantHeader  map[string]string
+		wantBody    string
+	}{
+		{
+			op: OpGetHealthz, method: http.MethodGet, target: "/healthz",
+			wantRequest: GetHealthzRequestObject{},
+			response:    GetHealthz200JSONResponse{Status: "ok"},
+			wantStatus:  http.StatusOK, wantBody: `{"status":"ok"}`,
+		},
+		{
+			op: OpPostOrder, method: http.MethodPost, target: "/order",
+			contentType: echo.MIMEApplicationJSON, body: `{"item":"Tea Table Green","price":1499}`,
+			header: map[string]string{IdempotencyKeyHeader: key, "Prefer": string(minimal)},
+			wantRequest: PostOrderRequestObject{
+				Params: PostOrderParams{IdempotencyKey: &key, Prefer: &minimal},
+				Body:   &PostOrderJSONRequestBody{Item: &green, Price: &price},
+			},
+			response:   PostOrder201Response{Headers: PostOrder201ResponseHeaders{Location: "/order/234578", PreferenceApplied: string(minimal)}},
+			wantStatus: http.StatusCreated,
+			wantHeader: map[string]string{"Location": "/order/234578", PreferenceAppliedHeader: string(minimal)},
+		},
+		{
+			op: OpDeleteOrderId, method: http.MethodDelete, target: "/order/234578",
+			wantRequest: DeleteOrderIdRequestObject{Id: id},
+			response:    DeleteOrderId404JSONResponse(failure),
+			wantStatus:  http.StatusNotFound, wantBody: `{"code":"not_found","message":"no order 234578"}`,
+		},
+		{
+			op: OpGetOrderId, method: http.MethodGet, target: "/order/234578?fields=price",
+			header: map[string]string{"If-Modified-Since": since.String(), "If-None-Match": tag},
+			wantRequest: GetOrderIdRequestObject{Id: id, Params: GetOrderIdParams{
+				Fields: &fields, IfModifiedSince: &since, IfNoneMatch: &tag,
+			}},
+			response: GetOrderId200JSONResponse{Body: order, Fields: fields, Headers: GetOrderId200ResponseHeaders{
+				ETag: tag, LastModified: since.String(), CacheControl: "no-cache",
+			}},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"ETag": tag, "Last-Modified": since.String(), "Cache-Control": "no-cache"},
+			wantBody:   `{"price":1499}`,
+		},
+		{
+			op: OpGetOrderId, name: "not modified", method: http.MethodGet, target: "/order/234578",
+			wantRequest: GetOrderIdRequestObject{Id: id},
+			response:    GetOrderId304Response{},
+			wantStatus:  http.StatusNotModified,
+			// No ETag was given, so none is sent.
+			wantHeader: map[string]string{"ETag": "", "Last-Modified": "", "Cache-Control": ""},
+		},
+		{
+			op: OpPatchOrderId, method: http.MethodPatch, target: "/order/234578",
+			contentType: echo.MIMEApplicationJSON, body: `{"item":"Tea Table Red"}`,
+			wantRequest: PatchOrderIdRequestObject{Id: id, Body: &PatchOrderIdJSONRequestBody{Item: &red}},
+			response:    PatchOrderId200JSONResponse{Body: order, Headers: PatchOrderId200ResponseHeaders{ETag: tag}},
+			wantStatus:  http.StatusOK, wantHeader: map[string]string{"ETag": tag},
+			wantBody: `{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}`,
+		},
+		{
+			op: OpPutOrderId, method: http.MethodPut, target: "/order/234578",
+			contentType: echo.MIMEApplicationJSON, body: `{"item":"Tea Table Green","total":{"amount":1499,"currency":"EUR"}}`,
+			header: map[string]string{"If-Match": tag, "Prefer": string(representation)},
+			wantRequest: PutOrderIdRequestObject{
+				Id:     id,
+				Params: PutOrderIdParams{IfMatch: &tag, Prefer: &representation},
+				Body:   &PutOrderIdJSONRequestBody{Item: green, Total: total},
+			},
+			response:   PutOrderId201JSONResponse{Body: order, Headers: PutOrderId201ResponseHeaders{PreferenceApplied: string(representation)}},
+			wantStatus: http.StatusCreated,
+			wantHeader: map[string]string{"ETag": "", PreferenceAppliedHeader: string(representation)},
+			wantBody:   `{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}`,
+		},
+		{
+			op: OpPutOrderId, name: "form", method: http.MethodPut, target: "/order/234578",
+			contentType: echo.MIMEApplicationForm, body: "item=Tea+Table+Green&amount=1499&currency=EUR",
+			header: map[string]string{"If-None-Match": "*"},
+			wantRequest: PutOrderIdRequestObject{
+				Id:     id,
+				Params: PutOrderIdParams{IfNoneMatch: stringPtr("*")},
+				Body:   &PutOrderIdJSONRequestBody{Item: green, Total: &Money{Amount: amount, Currency: currency}},
+			},
+			response:   PutOrderId412JSONResponse{Code: "precondition_failed", Message: "the order exists"},
+			wantStatus: http.StatusPreconditionFailed,
+			wantBody:   `{"code":"precondition_failed","message":"the order exists"}`,
+		},
+		{
+			op: OpListOrders, method: http.MethodGet, target: "/orders?cursor=next",
+			// The default of limit in the spec is applied.
+			wantRequest: ListOrdersRequestObject{Params: ListOrdersParams{Limit: &limit, Cursor: &cursor}},
+			response:    ListOrders200JSONResponse{Orders: []Order{order}},
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"orders":[{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}]}`,
+		},
+		{
+			op: OpExportOrders, method: http.MethodGet, target: "/orders/export",
+			wantRequest: ExportOrdersRequestObject{},
+			response: ExportOrders200ApplicationxNdjsonResponse{Body: func(yield func(Order, error) bool) {
+				yield(order, nil)
+			}},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{echo.HeaderContentType: "application/x-ndjson"},
+			wantBody:   `{"id":"234578","item":"Tea Table Green","price":1499,"total":{"amount":1499,"currency":"EUR"}}`,
+		},
+		{
+			op: OpBatchCreateOrders, method: http.MethodPost, target: "/orders:batch",
+			contentType: echo.MIMEApplicationJSON, body: `{"orders":[{"item":"Tea Table Green","price":1499}]}`,
+			wantRequest: BatchCreateOrdersRequestObject{
+				Body:   &BatchCreateOrdersJSONRequestBody{Orders: []OrderInput{{Item: &green, Price: &price}}},
+				Errors: []*Error{nil},
+			},
+			response:   BatchCreateOrders200JSONResponse{Results: []BatchCreateOrdersResult{{Id: &id}}},
+			wantStatus: http.StatusOK, wantBody: `{"results":[{"id":"234578"}]}`,
+		},
+		{
+			op: OpGetReadyz, method: http.MethodGet, target: "/readyz",
+			wantRequest: GetReadyzRequestObject{},
+			response:    GetReadyz503JSONResponse{Code: "unavailable", Message: "not ready"},
+			wantStatus:  http.StatusServiceUnavailable, wantBody: `{"code":"unavailable","message":"not ready"}`,
+		},
+		{
+			op: OpGetVersion, method: http.MethodGet, target: "/version",
+			wantRequest: GetVersionRequestObject{},
+			response: GetVersion429JSONResponse{
+				Body:    Error{Code: "too_many_requests", Message: "slow down"},
+				Headers: GetVersion429ResponseHeaders{RetryAfter: 3},
+			},
+			wantStatus: http.StatusTooManyRequests,
+			wantHeader: map[string]string{"Retry-After": "3"},
+			wantBody:   `{"code":"too_many_requests","message":"slow down"}`,
+		},
+	}
+
+	tested := make(map[string]bool)
+	for _, tt := range tests {
+		tested[tt.op] = true
+		t.Run(strings.TrimSpace(tt.op+" "+tt.name), func(t *testing.T) {
+			ssi := &strictServer{response: tt.response}
+			e := echo.New()
+			if err := RegisterHandlersWithOptions(e, NewStrictHandler(ssi)); err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, tt.contentType)
+			}
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if !reflect.DeepEqual(ssi.request, tt.wantRequest) {
+				t.Errorf("got the request object %s, want %s", dump(ssi.request), dump(tt.wantRequest))
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("got status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			for name, want := range tt.wantHeader {
+				if got, ok := rec.Header()[http.CanonicalHeaderKey(name)]; want == "" && ok || want != "" && strings.Join(got, ", ") != want {
+					t.Errorf("got the header %s %q, want %q", name, got, want)
+				}
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.wantBody {
+				t.Errorf("got the body %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+	for _, op := range Operations() {
+		if !tested[op.OperationId] {
+			t.Errorf("operation %s is not tested", op.OperationId)
+		}
+	}
+}
+
+func stringPtr(s string) *string { return &s }
+
+// dump formats v, a request object, with the values its pointers point to.
+func dump(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return fmt.Sprintf("%T%s", v, b)
+}
+
+// TestStrictResponses checks that the response objects of every operation
+// have the statuses of its responses in the spec, one at least for each.
+func TestStrictResponses(t *testing.T) {
+	responses := map[string][]interface{}{
+		OpGetHealthz: {
+			GetHealthz200JSONResponse{}, GetHealthz429JSONResponse{}, GetHealthzdefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpPostOrder: {
+			PostOrder201JSONResponse{}, PostOrder201Response{}, PostOrder400JSONResponse{}, PostOrder401JSONResponse{}, PostOrder409JSONResponse{}, PostOrder413JSONResponse{}, PostOrder415JSONResponse{}, PostOrder422JSONResponse{}, PostOrder429JSONResponse{}, PostOrder500JSONResponse{}, PostOrderdefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpDeleteOrderId: {
+			DeleteOrderId204Response{}, DeleteOrderId400JSONResponse{}, DeleteOrderId401JSONResponse{}, DeleteOrderId404JSONResponse{}, DeleteOrderId429JSONResponse{}, DeleteOrderId500JSONResponse{}, DeleteOrderIddefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpGetOrderId: {
+			GetOrderId200JSONResponse{}, GetOrderId304Response{}, GetOrderId400JSONResponse{}, GetOrderId401JSONResponse{}, GetOrderId404JSONResponse{}, GetOrderId429JSONResponse{}, GetOrderId500JSONResponse{}, GetOrderIddefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpPatchOrderId: {
+			PatchOrderId200JSONResponse{}, PatchOrderId400JSONResponse{}, PatchOrderId401JSONResponse{}, PatchOrderId404JSONResponse{}, PatchOrderId413JSONResponse{}, PatchOrderId415JSONResponse{}, PatchOrderId429JSONResponse{}, PatchOrderId500JSONResponse{}, PatchOrderIddefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpPutOrderId: {
+			PutOrderId201Response{}, PutOrderId201JSONResponse{}, PutOrderId400JSONResponse{}, PutOrderId401JSONResponse{}, PutOrderId409JSONResponse{}, PutOrderId412JSONResponse{}, PutOrderId413JSONResponse{}, PutOrderId415JSONResponse{}, PutOrderId429JSONResponse{}, PutOrderId500JSONResponse{}, PutOrderIddefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpListOrders: {
+			ListOrders200JSONResponse{}, ListOrders400JSONResponse{}, ListOrders401JSONResponse{}, ListOrders429JSONResponse{}, ListOrders500JSONResponse{}, ListOrdersdefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpExportOrders: {
+			ExportOrders200ApplicationxNdjsonResponse{Body: func(func(Order, error) bool) {}}, ExportOrders401JSONResponse{}, ExportOrders429JSONResponse{}, ExportOrders500JSONResponse{},
+		},
+		OpBatchCreateOrders: {
+			BatchCreateOrders200JSONResponse{}, BatchCreateOrders400JSONResponse{}, BatchCreateOrders401JSONResponse{}, BatchCreateOrders413JSONResponse{}, BatchCreateOrders415JSONResponse{}, BatchCreateOrders429JSONResponse{}, BatchCreateOrders500JSONResponse{}, BatchCreateOrdersdefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpGetReadyz: {
+			GetReadyz200JSONResponse{}, GetReadyz429JSONResponse{}, GetReadyz503JSONResponse{}, GetReadyzdefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+		OpGetVersion: {
+			GetVersion200JSONResponse{}, GetVersion429JSONResponse{}, GetVersiondefaultJSONResponse{StatusCode: http.StatusBadGateway},
+		},
+	}
+
+	for _, info := range Operations() {
+		op := operationByID(info.OperationId)
+		if op == nil {
+			t.Fatalf("no operation %s", info.OperationId)
+		}
+		covered := make(map[string]bool)
+		for _, rsp := range responses[info.OperationId] {
+			rec := httptest.NewRecorder()
+			if err := visitStrictResponse(rsp, rec); err != nil {
+				t.Fatalf("%T: %s", rsp, err)
+			}
+			status := strconv.Itoa(rec.Code)
+			if strings.Contains(fmt.Sprintf("%T", rsp), "default") {
+				status = "default"
+			}
+			if op.spec.Responses[status] == nil {
+				t.Errorf("%T has the status %s, which %s doesn't respond with", rsp, status, info.OperationId)
+			}
+			covered[status] = true
+		}
+		for status := range op.spec.Responses {
+			if !covered[status] {
+				t.Errorf("%s has no response object for its %s response", info.OperationId, status)
+			}
+		}
+	}
+}
+
+// visitStrictResponse writes rsp, the response object of an operation, to w.
+func visitStrictResponse(rsp interface{}, w http.ResponseWriter) error {
+	switch rsp := rsp.(type) {
+	case GetHealthzResponseObject:
+		return rsp.VisitGetHealthzResponse(w)
+	case PostOrderResponseObject:
+		return rsp.VisitPostOrderResponse(w)
+	case DeleteOrderIdResponseObject:
+		return rsp.VisitDeleteOrderIdResponse(w)
+	case GetOrderIdResponseObject:
+		return rsp.VisitGetOrderIdResponse(w)
+	case PatchOrderIdResponseObject:
+		return rsp.VisitPatchOrderIdResponse(w)
+	case PutOrderIdResponseObject:
+		return rsp.VisitPutOrderIdResponse(w)
+	case ListOrdersResponseObject:
+		return rsp.VisitListOrdersResponse(w)
+	case ExportOrdersResponseObject:
+		return rsp.VisitExportOrdersResponse(w)
+	case BatchCreateOrdersResponseObject:
+		return rsp.VisitBatchCreateOrdersResponse(w)
+	case GetReadyzResponseObject:
+		return rsp.VisitGetReadyzResponse(w)
+	case GetVersionResponseObject:
+		return rsp.VisitGetVersionResponse(w)
+	}
+	return fmt.Errorf("not a response object")
+}
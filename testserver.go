@@ -0,0 +1,31 @@
+package spec
+
+import (
+	"net/http/httptest"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewTestServer serves si on a local httptest server and returns a client of
+// it, along with a function which shuts the server down. Requests are
+// validated against the spec, as with WithValidation, and opts configure the
+// routes further, like for RegisterHandlersWithOptions. It is meant for
+// tests, so it panics if the handlers can't be registered.
+func NewTestServer(si ServerInterface, opts ...RegisterOption) (*ClientWithResponses, func()) {
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.Use(VersionMiddleware())
+	opts = append([]RegisterOption{WithValidation()}, opts...)
+	if err := RegisterHandlersWithOptions(e, si, opts...); err != nil {
+		panic(err)
+	}
+
+	server := httptest.NewServer(e)
+	client, err := NewClientWithResponses(server.URL, WithHTTPClient(server.Client()))
+	if err != nil {
+		server.Close()
+		panic(err)
+	}
+	return client, server.Close
+}
@@ -0,0 +1,105 @@
+package spec
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceContext propagates spans across the client/server boundary in the
+// W3C traceparent and tracestate headers.
+var traceContext = propagation.TraceContext{}
+
+// WithTracer traces every request of the client with tracer. Each call starts
+// a client span named after its operation, e.g. PutOrderId, which is
+// propagated to the server. The span covers the retries of WithRetry and
+// the cache of WithResponseCache too.
+func WithTracer(tracer trace.Tracer) ClientOption {
+	return func(c *Client) error {
+		c.setLayer(tracingLayer, func(doer HttpRequestDoer) HttpRequestDoer {
+			return &tracingDoer{doer: doer, tracer: tracer, client: c}
+		})
+		return nil
+	}
+}
+
+type tracingDoer struct {
+	doer   HttpRequestDoer
+	tracer trace.Tracer
+	client *Client
+}
+
+func (d *tracingDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx, span := d.tracer.Start(req.Context(), spanName(req.Method, clientOperationID(d.client.Server, req)),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", req.URL.String()),
+		),
+	)
+	defer span.End()
+
+	req = req.Clone(ctx)
+	traceContext.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	rsp, err := d.doer.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	setStatus(span, rsp.StatusCode, http.StatusBadRequest)
+	return rsp, nil
+}
+
+// OtelMiddleware returns a middleware which traces requests with tracer. It
+// continues the trace propagated by the client, if any, with a server span
+// named after the operation, which handlers can find in the request context.
+func OtelMiddleware(tracer trace.Tracer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := traceContext.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			ctx, span := tracer.Start(ctx, spanName(req.Method, routeOperationID(req.Method, c.Path())),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("http.route", c.Path()),
+					attribute.String("url.path", req.URL.Path),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+			err := next(c)
+
+			if err != nil {
+				span.RecordError(err)
+			}
+			setStatus(span, responseStatus(c, err), http.StatusInternalServerError)
+			return err
+		}
+	}
+}
+
+// spanName names the span of a request with method after its operation op,
+// or after method if it is not for an operation of the spec.
+func spanName(method, op string) string {
+	if op != "" {
+		return op
+	}
+	return "HTTP " + method
+}
+
+// setStatus records the response status on span, marking it as failed if
+// status is at least errorStatus.
+func setStatus(span trace.Span, status, errorStatus int) {
+	span.SetAttributes(attribute.Int("http.response.status_code", status))
+	if status >= errorStatus {
+		span.SetStatus(codes.Error, http.StatusText(status))
+	}
+}
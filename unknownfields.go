@@ -0,0 +1,124 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+// WithDisallowUnknownFields rejects JSON request bodies with fields the spec
+// doesn't define, e.g. a misspelt "prise", with 400 and an Error naming every
+// unknown field, instead of ignoring them. The orders of a batch are checked
+// one by one, so that an order with unknown fields gets a 400 result of its
+// own instead of failing the batch.
+func WithDisallowUnknownFields() RegisterOption {
+	return func(cfg *registerConfig) {
+		cfg.disallowUnknownFields = true
+	}
+}
+
+// disallowUnknownFields returns a middleware which rejects JSON request
+// bodies with fields the schema of the request body doesn't define. Bodies
+// which aren't JSON, or not even well-formed, are left to the handler. So are
+// the items of bodies which are validated one by one, through the context of
+// the request, for decodeOrderInput.
+func disallowUnknownFields() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+				return next(c)
+			}
+			op := findRoute(req.Method, c.Path())
+			if op == nil || op.spec.RequestBody == nil {
+				return next(c)
+			}
+			if validatesItemsIndividually(op.spec) {
+				c.SetRequest(req.WithContext(context.WithValue(req.Context(), disallowUnknownFieldsKey{}, true)))
+				return next(c)
+			}
+			media := op.spec.RequestBody.Value.Content.Get(echo.MIMEApplicationJSON)
+			if media == nil || media.Schema == nil {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			var value interface{}
+			if err := json.Unmarshal(body, &value); err != nil {
+				return next(c)
+			}
+			if details := unknownFields("", value, media.Schema.Value); len(details) > 0 {
+				return c.JSON(http.StatusBadRequest, Error{
+					Code:    "unknown_field",
+					Message: "the request body has fields the spec doesn't define",
+					Details: &details,
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
+type disallowUnknownFieldsKey struct{}
+
+// unknownFieldsDisallowed reports whether WithDisallowUnknownFields is on for
+// the request with ctx as its context.
+func unknownFieldsDisallowed(ctx context.Context) bool {
+	disallowed, _ := ctx.Value(disallowUnknownFieldsKey{}).(bool)
+	return disallowed
+}
+
+// unknownFields returns a detail for every field of value, prefixed by
+// prefix, which schema doesn't define, in the form of validationDetails.
+func unknownFields(prefix string, value interface{}, schema *openapi3.Schema) []string {
+	var details []string
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if len(schema.Properties) == 0 || allowsAdditionalProperties(schema) {
+			break
+		}
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := schema.Properties[name]
+			switch {
+			case ok && property.Value != nil:
+				details = append(details, unknownFields(prefix+name+".", value[name], property.Value)...)
+			case !ok:
+				details = append(details, prefix+name+": unknown field")
+			}
+		}
+	case []interface{}:
+		if schema.Items == nil || schema.Items.Value == nil {
+			break
+		}
+		for i, item := range value {
+			details = append(details, unknownFields(prefix+strconv.Itoa(i)+".", item, schema.Items.Value)...)
+		}
+	}
+	return details
+}
+
+// allowsAdditionalProperties reports whether schema explicitly allows
+// properties it doesn't define.
+func allowsAdditionalProperties(schema *openapi3.Schema) bool {
+	return schema.AdditionalProperties != nil ||
+		(schema.AdditionalPropertiesAllowed != nil && *schema.AdditionalPropertiesAllowed)
+}
@@ -0,0 +1,68 @@
+package spec
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestDisallowUnknownFields(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		opts      []RegisterOption
+		wantError bool
+	}{
+		{"off", nil, false},
+		{"on", []RegisterOption{WithDisallowUnknownFields()}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			if err := RegisterHandlersWithOptions(e, NewInMemoryStore(), tt.opts...); err != nil {
+				t.Fatal(err)
+			}
+			serve := func(method, target, body string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(method, target, strings.NewReader(body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				return rec
+			}
+
+			rec := serve(http.MethodPut, "/order/234578", `{"item":"Tea Table Green","price":1499,"prise":1}`)
+			if want := map[bool]int{false: http.StatusCreated, true: http.StatusBadRequest}[tt.wantError]; rec.Code != want {
+				t.Errorf("got status %d for an order with an unknown field, want %d: %s", rec.Code, want, rec.Body)
+			}
+
+			rec = serve(http.MethodPost, "/orders:batch",
+				`{"orders":[{"item":"Tea Table Green","price":1499},{"item":"Tea Table Red","price":1499,"prise":1}]}`)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("got status %d for a batch, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			var batch BatchCreateOrdersOutput
+			if err := json.Unmarshal(rec.Body.Bytes(), &batch); err != nil {
+				t.Fatal(err)
+			}
+			if len(batch.Results) != 2 {
+				t.Fatalf("got %s, want 2 results", rec.Body)
+			}
+			if batch.Results[0].Id == nil {
+				t.Errorf("got %s, want the first order created", rec.Body)
+			}
+			second := batch.Results[1]
+			if !tt.wantError {
+				if second.Id == nil {
+					t.Errorf("got %s, want the second order created", rec.Body)
+				}
+				return
+			}
+			if second.Id != nil || second.Error == nil || second.Error.Code != "unknown_field" ||
+				second.Error.Details == nil || strings.Join(*second.Error.Details, "; ") != "prise: unknown field" {
+				t.Errorf("got %s, want an unknown_field error for the prise of the second order", rec.Body)
+			}
+		})
+	}
+}
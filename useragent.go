@@ -0,0 +1,38 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+)
+
+// userAgent overrides DefaultUserAgent when it is set at build time, e.g.
+// with -ldflags "-X article-openapi.userAgent=article-openapi-go/1.2.3".
+// DefaultUserAgent itself is computed, so -X can't set it.
+var userAgent string
+
+// DefaultUserAgent is the User-Agent every client sends, unless it is created
+// with another one. It reports SpecVersion unless userAgent is set.
+var DefaultUserAgent = defaultUserAgent()
+
+func defaultUserAgent() string {
+	if userAgent != "" {
+		return userAgent
+	}
+	return "article-openapi-go/" + SpecVersion
+}
+
+// WithUserAgent sets the User-Agent header of every request of the client to
+// ua, or to DefaultUserAgent if ua is empty. NewClient sets DefaultUserAgent
+// already, rather than the one of Go, so that the client can be told apart in
+// server logs; this option overrides it. To append to the default rather than
+// override it, pass e.g. "myapp/2.0 " + DefaultUserAgent. Request editors
+// passed to a call can still override it.
+func WithUserAgent(ua string) ClientOption {
+	if ua == "" {
+		ua = DefaultUserAgent
+	}
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", ua)
+		return nil
+	})
+}
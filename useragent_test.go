@@ -0,0 +1,50 @@
+package spec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	setUserAgent := func(ua string) RequestEditorFn {
+		return func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("User-Agent", ua)
+			return nil
+		}
+	}
+	for _, test := range []struct {
+		name    string
+		opts    []ClientOption
+		editors []RequestEditorFn
+		want    string
+	}{
+		{name: "default", want: DefaultUserAgent},
+		{name: "option", opts: []ClientOption{WithUserAgent("myapp/2.0")}, want: "myapp/2.0"},
+		{name: "empty option", opts: []ClientOption{WithUserAgent("")}, want: DefaultUserAgent},
+		{name: "client editor", opts: []ClientOption{WithRequestEditorFn(setUserAgent("myapp/2.0"))}, want: "myapp/2.0"},
+		{name: "call editor", opts: []ClientOption{WithUserAgent("myapp/2.0")}, editors: []RequestEditorFn{setUserAgent("myapp/3.0")}, want: "myapp/3.0"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewClientWithResponses(server.URL, test.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = ""
+			if _, err := client.GetOrderIdWithResponse(context.Background(), "234578", &GetOrderIdParams{}, test.editors...); err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got User-Agent %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/labstack/echo/v4"
+)
+
+// newRequestValidator returns a middleware which validates requests against
+// the embedded OpenAPI spec. Requests which don't conform to the spec are
+// rejected with 400 and an Error detailing every offending field. Routes
+// served under a prefix are matched on the path after it.
+func newRequestValidator() (echo.MiddlewareFunc, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("error loading spec: %s", err)
+	}
+	// Routes are matched on the path only, wherever the API is deployed.
+	swagger.Servers = nil
+
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating router: %s", err)
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			routeReq := req
+			if prefix := routePrefix(req.Method, c.Path()); prefix != "" {
+				u := *req.URL
+				u.Path = strings.TrimPrefix(u.Path, prefix)
+				u.RawPath = strings.TrimPrefix(u.RawPath, prefix)
+				routeReq = req.Clone(req.Context())
+				routeReq.URL = &u
+			}
+			route, pathParams, err := router.FindRoute(routeReq)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, Error{
+					Code:    "invalid_request",
+					Message: err.Error(),
+				})
+			}
+
+			validateReq := withoutEmptyArrays(req, route.Operation)
+			form := isFormBody(req)
+			err = openapi3filter.ValidateRequest(req.Context(), &openapi3filter.RequestValidationInput{
+				Request:    validateReq,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					MultiError: true,
+					// Form bodies are validated by validateFormBody.
+					ExcludeRequestBody: form || validatesItemsIndividually(route.Operation),
+					// Authentication is left to RequireAPIKey.
+					AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+				},
+			})
+			// The validation reads the body and puts it back, in the request
+			// it validates.
+			req.Body = validateReq.Body
+			if form {
+				if formErr := validateFormBody(req, route.Operation); formErr != nil {
+					if err == nil {
+						err = formErr
+					} else {
+						err = openapi3.MultiError{err, formErr}
+					}
+				}
+			}
+			if err != nil {
+				details := validationDetails(err)
+				if len(details) == 0 {
+					return err
+				}
+				return c.JSON(http.StatusBadRequest, Error{
+					Code:    "invalid_request",
+					Message: "the request does not conform to the spec",
+					Details: &details,
+				})
+			}
+			return next(c)
+		}
+	}, nil
+}
+
+// withoutEmptyArrays returns req without the empty items of the query
+// parameters of op which are arrays, like the first of fields=,price, and
+// without the parameters left empty, like fields=, so that they count as
+// omitted rather than as an array of one empty item, as the handlers take
+// them. It returns req itself if it has none.
+func withoutEmptyArrays(req *http.Request, op *openapi3.Operation) *http.Request {
+	query := req.URL.Query()
+	changed := false
+	for _, ref := range op.Parameters {
+		param := ref.Value
+		if param == nil || param.In != openapi3.ParameterInQuery || param.Schema == nil || param.Schema.Value.Type != "array" {
+			continue
+		}
+		values, ok := query[param.Name]
+		if !ok {
+			continue
+		}
+		var kept []string
+		for _, value := range values {
+			var items []string
+			for _, item := range strings.Split(value, ",") {
+				if strings.TrimSpace(item) != "" {
+					items = append(items, item)
+				}
+			}
+			if len(items) > 0 {
+				kept = append(kept, strings.Join(items, ","))
+			}
+		}
+		if len(kept) == len(values) && strings.Join(kept, "&") == strings.Join(values, "&") {
+			continue
+		}
+		changed = true
+		if len(kept) == 0 {
+			query.Del(param.Name)
+		} else {
+			query[param.Name] = kept
+		}
+	}
+	if !changed {
+		return req
+	}
+
+	u := *req.URL
+	u.RawQuery = query.Encode()
+	clone := req.Clone(req.Context())
+	clone.URL = &u
+	return clone
+}
+
+// validatesItemsIndividually reports whether the handler of op validates
+// the items of its request body itself, so that an invalid item doesn't fail
+// the whole request, as the x-validate-items-individually extension of the
+// body says.
+func validatesItemsIndividually(op *openapi3.Operation) bool {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return false
+	}
+	_, ok := op.RequestBody.Value.Extensions["x-validate-items-individually"]
+	return ok
+}
+
+var (
+	orderInputSchemaOnce sync.Once
+	orderInputSchema     *openapi3.Schema
+)
+
+// decodeOrderInput decodes an order of a batch, validating it against the
+// OrderInput schema of the spec on its own. If the order is invalid, it
+// returns an Error saying why instead. If WithDisallowUnknownFields is on for
+// the request with ctx as its context, an order with fields the spec doesn't
+// define is invalid too.
+func decodeOrderInput(ctx context.Context, raw json.RawMessage) (OrderInput, *Error) {
+	orderInputSchemaOnce.Do(func() {
+		swagger, err := GetSwagger()
+		if err != nil {
+			return
+		}
+		if ref := swagger.Components.Schemas["OrderInput"]; ref != nil {
+			orderInputSchema = ref.Value
+		}
+	})
+
+	var input OrderInput
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return input, &Error{Code: "invalid_request", Message: err.Error()}
+	}
+	if orderInputSchema != nil && unknownFieldsDisallowed(ctx) {
+		if details := unknownFields("", value, orderInputSchema); len(details) > 0 {
+			return input, &Error{
+				Code:    "unknown_field",
+				Message: "the order has fields the spec doesn't define",
+				Details: &details,
+			}
+		}
+	}
+	if orderInputSchema != nil {
+		if err := orderInputSchema.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+			var details []string
+			if errs, ok := err.(openapi3.MultiError); ok {
+				for _, err := range errs {
+					details = append(details, fieldError("order", err))
+				}
+			} else {
+				details = []string{fieldError("order", err)}
+			}
+			return input, &Error{
+				Code:    "invalid_request",
+				Message: "the order does not conform to the spec",
+				Details: &details,
+			}
+		}
+	}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return input, &Error{Code: "invalid_request", Message: err.Error()}
+	}
+	return input, nil
+}
+
+// validationDetails describes every check of the spec which err reports as
+// failed, as "field: reason". It returns nil if err is not a validation error.
+func validationDetails(err error) []string {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var details []string
+		for _, err := range e {
+			details = append(details, validationDetails(err)...)
+		}
+		return details
+	case *openapi3filter.RequestError:
+		field := "body"
+		if e.Parameter != nil {
+			field = e.Parameter.Name
+		}
+		switch cause := e.Err.(type) {
+		case nil:
+			return []string{field + ": " + e.Reason}
+		case openapi3.MultiError:
+			details := make([]string, 0, len(cause))
+			for _, err := range cause {
+				details = append(details, fieldError(field, err))
+			}
+			return details
+		default:
+			return []string{fieldError(field, cause)}
+		}
+	}
+	return nil
+}
+
+// fieldError describes err, which failed the validation of field. Schema
+// errors are attributed to the nested field they occurred at.
+func fieldError(field string, err error) string {
+	schemaErr, ok := err.(*openapi3.SchemaError)
+	if !ok {
+		return field + ": " + err.Error()
+	}
+	if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+		field = strings.Join(pointer, ".")
+	}
+	return field + ": " + schemaErr.Reason
+}
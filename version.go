@@ -0,0 +1,33 @@
+package spec
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIVersionHeader is the response header VersionMiddleware reports
+// SpecVersion in.
+const APIVersionHeader = "X-API-Version"
+
+// VersionMiddleware returns a middleware which sets the X-API-Version header
+// of every response to SpecVersion, error responses included. Install it with
+// echo.Echo.Use to cover responses of the router too, e.g. for unknown paths.
+func VersionMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Response().Header().Set(APIVersionHeader, SpecVersion)
+			return next(c)
+		}
+	}
+}
+
+// VersionHandler implements GetVersion of ServerInterface. Embed it in an
+// implementation to serve it.
+type VersionHandler struct{}
+
+// GetVersion reports SpecVersion and the version of the OpenAPI
+// Specification the API is described with.
+func (VersionHandler) GetVersion(c echo.Context) error {
+	return c.JSON(http.StatusOK, Version{Version: SpecVersion, Spec: openAPIVersion})
+}